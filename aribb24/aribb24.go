@@ -0,0 +1,516 @@
+// Package aribb24 decodes ARIB STD-B24 8-unit closed caption character
+// strings (the statement body of a data_unit_parameter == 0x20 data
+// unit) into plain text or styled runs suitable for subtitle renderers.
+//
+// [B24]: ARIB STD-B24
+package aribb24
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Run is a contiguous span of decoded text sharing the same style.
+type Run struct {
+	Text  string
+	Color string  // bare "bbggrr" hex, as used in an ASS &H..& override; empty means the default color
+	Size  float64 // relative font scale; 0 means the default size
+}
+
+// Result is the outcome of decoding a single caption statement.
+type Result struct {
+	// Runs holds the decoded text split at style changes, in display order.
+	Runs []Run
+	// Text is Runs concatenated, with "\N" inserted at APS/APR/APD
+	// cursor movements so callers that don't care about styling can
+	// use it directly.
+	Text string
+	// TimeShiftCentiseconds is non-zero when the statement carried a
+	// TIME control ([B24] 8.3.2) that should shift the caption's
+	// display timestamp, in hundredths of a second.
+	TimeShiftCentiseconds int
+}
+
+// DrcsFallback is substituted for DRCS (externally defined / mosaic)
+// characters, which this package cannot render. Callers may override
+// it with a different placeholder.
+var DrcsFallback = "〓" // GETA MARK
+
+// Decode decodes a caption statement and returns its plain text.
+func Decode(data []byte) (string, error) {
+	r, err := DecodeWithStyle(data)
+	if err != nil {
+		return "", err
+	}
+	return r.Text, nil
+}
+
+// charset identifies one of the G-sets profile C captions can designate.
+type charset int
+
+const (
+	csKanji charset = iota
+	csAlphanumeric
+	csHiragana
+	csKatakana
+	csDrcs  // DRCS-1 through DRCS-15: one byte per character
+	csDrcs0 // DRCS-0: two bytes per character, like csKanji
+	csMacro
+)
+
+// DecodeWithStyle behaves like Decode but also returns the text split
+// into style runs, so a caller rendering to a format with inline
+// styling (e.g. ASS) can preserve color and size changes.
+func DecodeWithStyle(data []byte) (Result, error) {
+	d := &decoder{
+		data: data,
+		// [B24] Table 9-10: initial state for profile C.
+		g:  [4]charset{csKanji, csAlphanumeric, csHiragana, csKatakana},
+		gl: 0,
+		gr: 2,
+	}
+	return d.run()
+}
+
+type decoder struct {
+	data []byte
+	pos  int
+
+	g  [4]charset // G0-G3 designations
+	gl int        // index into g currently invoked into GL
+	gr int        // index into g currently invoked into GR
+
+	runs  []Run
+	cur   []rune
+	color string
+	size  float64
+
+	result Result
+}
+
+func (d *decoder) run() (Result, error) {
+	for d.pos < len(d.data) {
+		b := d.data[d.pos]
+		switch {
+		case b == 0x1B:
+			if err := d.escape(); err != nil {
+				return Result{}, err
+			}
+		case b == 0x19: // SS2: single shift into G2 for one character
+			d.pos++
+			if err := d.invokeOnce(2); err != nil {
+				return Result{}, err
+			}
+		case b == 0x1D: // SS3: single shift into G3 for one character
+			d.pos++
+			if err := d.invokeOnce(3); err != nil {
+				return Result{}, err
+			}
+		case b < 0x20:
+			if err := d.c0(b); err != nil {
+				return Result{}, err
+			}
+		case b == 0x20 || b == 0x7F:
+			d.emitRune(' ')
+			d.pos++
+		case b < 0x80:
+			if err := d.decodeChar(d.gl); err != nil {
+				return Result{}, err
+			}
+		case b < 0xA0:
+			if err := d.c1(b); err != nil {
+				return Result{}, err
+			}
+		default:
+			if err := d.decodeChar(d.gr); err != nil {
+				return Result{}, err
+			}
+		}
+	}
+	d.flushRun()
+	d.result.Text = joinRuns(d.runs)
+	d.result.Runs = d.runs
+	return d.result, nil
+}
+
+// invokeOnce decodes exactly one character from G-set index g, without
+// permanently changing GL (SS2/SS3 semantics).
+func (d *decoder) invokeOnce(g int) error {
+	return d.decodeChar(g)
+}
+
+func (d *decoder) decodeChar(gIndex int) error {
+	if d.pos >= len(d.data) {
+		return fmt.Errorf("aribb24: truncated character at offset %d", d.pos)
+	}
+	switch d.g[gIndex] {
+	case csKanji:
+		return d.decodeKanji()
+	case csAlphanumeric:
+		d.emitRune(decodeAlphanumeric(d.data[d.pos] & 0x7F))
+		d.pos++
+	case csHiragana:
+		d.emitRune(lookupKuten(hiraganaTable, d.data[d.pos]&0x7F))
+		d.pos++
+	case csKatakana:
+		d.emitRune(lookupKuten(katakanaTable, d.data[d.pos]&0x7F))
+		d.pos++
+	case csDrcs:
+		d.flushRun()
+		d.cur = append(d.cur, []rune(DrcsFallback)...)
+		d.pos++
+	case csDrcs0:
+		if d.pos+1 >= len(d.data) {
+			return fmt.Errorf("aribb24: truncated DRCS-0 character at offset %d", d.pos)
+		}
+		d.flushRun()
+		d.cur = append(d.cur, []rune(DrcsFallback)...)
+		d.pos += 2
+	case csMacro:
+		d.pos++
+	}
+	return nil
+}
+
+func (d *decoder) decodeKanji() error {
+	if d.pos+1 >= len(d.data) {
+		return fmt.Errorf("aribb24: truncated kanji at offset %d", d.pos)
+	}
+	b1 := d.data[d.pos] & 0x7F
+	b2 := d.data[d.pos+1] & 0x7F
+	d.pos += 2
+	if r, ok := kanjiTable[kuten{row: b1, cell: b2}]; ok {
+		d.emitRune(r)
+	} else {
+		d.emitRune('�')
+	}
+	return nil
+}
+
+// escape handles ESC-prefixed G-set designation and invocation
+// sequences. [B24] 8.2.
+func (d *decoder) escape() error {
+	d.pos++ // consume ESC
+	if d.pos >= len(d.data) {
+		return fmt.Errorf("aribb24: truncated escape sequence")
+	}
+	switch d.data[d.pos] {
+	case 0x6E: // LS2
+		d.pos++
+		d.gl = 2
+	case 0x6F: // LS3
+		d.pos++
+		d.gl = 3
+	case 0x7E: // LS1R
+		d.pos++
+		d.gr = 1
+	case 0x7D: // LS2R
+		d.pos++
+		d.gr = 2
+	case 0x7C: // LS3R
+		d.pos++
+		d.gr = 3
+	default:
+		return d.designate()
+	}
+	return nil
+}
+
+// designate parses a G-set designation sequence such as
+// ESC 0x24 0x42 (designate JIS kanji to G0). [B24] Table 7-3.
+func (d *decoder) designate() error {
+	start := d.pos
+	multiByte := false
+	if d.data[d.pos] == 0x24 {
+		multiByte = true
+		d.pos++
+	}
+	if d.pos >= len(d.data) {
+		return fmt.Errorf("aribb24: truncated designation sequence at %d", start)
+	}
+	fByte := d.data[d.pos]
+	var gIndex int
+	switch fByte {
+	case 0x28:
+		gIndex = 0
+	case 0x29:
+		gIndex = 1
+	case 0x2A:
+		gIndex = 2
+	case 0x2B:
+		gIndex = 3
+	default:
+		// Designation directly to G0 without an explicit Fn byte,
+		// e.g. ESC 0x24 0x42 (kanji -> G0).
+		gIndex = 0
+		d.pos--
+	}
+	d.pos++
+	if d.pos >= len(d.data) {
+		return fmt.Errorf("aribb24: truncated designation sequence at %d", start)
+	}
+	set := d.data[d.pos]
+	d.pos++
+	d.g[gIndex] = designatedCharset(set, multiByte)
+	return nil
+}
+
+// [B24] Table 7-3 designation bytes (profile C subset).
+const (
+	setKanji    = 0x42
+	setAlphanum = 0x4A
+	setHiragana = 0x30
+	setKatakana = 0x31
+	setMacro    = 0x70
+	setDrcs0    = 0x40 // multi-byte, designated with the 0x24 lead byte
+)
+
+func designatedCharset(set byte, multiByte bool) charset {
+	switch {
+	case multiByte && set == setDrcs0:
+		return csDrcs0
+	case multiByte:
+		return csKanji
+	case set == setAlphanum:
+		return csAlphanumeric
+	case set == setHiragana:
+		return csHiragana
+	case set == setKatakana:
+		return csKatakana
+	case set == setMacro:
+		return csMacro
+	case set >= 0x41 && set <= 0x4F:
+		return csDrcs // DRCS-1 through DRCS-15
+	default:
+		return csKanji
+	}
+}
+
+// c0 handles the C0 control code set. [B24] Table 7-2.
+func (d *decoder) c0(b byte) error {
+	switch b {
+	case 0x08: // APB: active position backward
+		d.pos++
+	case 0x09: // APF: active position forward
+		d.emitRune(' ')
+		d.pos++
+	case 0x0A, 0x0B: // APD/APU: active position down/up -> newline
+		d.newline()
+		d.pos++
+	case 0x0C: // CS: clear screen -> flush what's pending
+		d.flushRun()
+		d.pos++
+	case 0x0D: // APR: active position return -> newline
+		d.newline()
+		d.pos++
+	case 0x16: // PAPF: parameter active position forward, takes 1 param
+		d.pos += 2
+	case 0x1C: // APS: active position set, takes 2 params (y, x)
+		d.newline()
+		d.pos += 3
+	case 0x0E: // LS1: locking shift into GL from G1
+		d.gl = 1
+		d.pos++
+	case 0x0F: // LS0: locking shift into GL from G0
+		d.gl = 0
+		d.pos++
+	case 0x18: // CAN: cancel
+		d.pos++
+	case 0x1E, 0x1F: // RS/US
+		d.pos++
+	default:
+		d.pos++
+	}
+	return nil
+}
+
+// c1 handles the C1 control code set, which on profile C carries color,
+// size and other presentation controls. [B24] Table 7-3.
+func (d *decoder) c1(b byte) error {
+	switch {
+	case b >= 0x80 && b <= 0x87: // BKF..WHF: foreground color
+		d.flushRun()
+		d.color = ssColors[b-0x80]
+		d.pos++
+	case b == 0x88, b == 0x89, b == 0x8A: // SSZ/MSZ/NSZ: character size
+		d.flushRun()
+		d.size = []float64{0.5, 0.75, 1.0}[b-0x88]
+		d.pos++
+	case b == 0x90: // COL: color control, 1+ params
+		d.flushRun()
+		d.pos++
+		if d.pos < len(d.data) && d.data[d.pos] >= 0x40 {
+			if c, ok := colControl(d.data[d.pos]); ok {
+				d.color = c
+			}
+			d.pos++
+		} else if d.pos < len(d.data) {
+			d.pos++ // palette selector, not a direct color - skip
+		}
+	case b == 0x9B: // CSI: parse and strip/translate
+		return d.csi()
+	case b == 0x9D: // TIME control
+		return d.time()
+	case b == 0x95: // MACRO: ignored, no inline params
+		d.pos++
+	default:
+		d.pos++
+	}
+	return nil
+}
+
+// csi strips a CSI sequence (ESC-free form, introduced by 0x9B). No
+// CSI function is translated into an ASS override; the whole sequence
+// is discarded. [B24] 8.3.1.
+func (d *decoder) csi() error {
+	d.pos++ // consume 0x9B
+	start := d.pos
+	for d.pos < len(d.data) && d.data[d.pos] >= 0x30 && d.data[d.pos] <= 0x3F {
+		d.pos++
+	}
+	params := string(d.data[start:d.pos])
+	for d.pos < len(d.data) && d.data[d.pos] >= 0x20 && d.data[d.pos] <= 0x2F {
+		d.pos++
+	}
+	if d.pos >= len(d.data) {
+		return fmt.Errorf("aribb24: truncated CSI sequence")
+	}
+	final := d.data[d.pos]
+	d.pos++
+	switch final {
+	case 'W': // SWF/SDF-style sizing functions: best effort, ignored
+	case 'S': // GSM: character deformation, ignored
+	case 0x20: // intermediate already consumed above in normal case
+	default:
+		_ = params // other CSI functions carry no text-visible effect we render
+	}
+	return nil
+}
+
+// time handles the TIME control code. A group byte of 0x20 designates
+// a relative wait expressed in a single BCD byte (hundredths of a
+// second); 0x28 designates an absolute HH:MM:SS designation which we
+// don't resolve to an offset and is skipped. [B24] 8.3.2.
+func (d *decoder) time() error {
+	d.pos++ // consume 0x9D
+	if d.pos >= len(d.data) {
+		return fmt.Errorf("aribb24: truncated TIME control")
+	}
+	group := d.data[d.pos]
+	d.pos++
+	switch group {
+	case 0x20:
+		if d.pos >= len(d.data) {
+			return fmt.Errorf("aribb24: truncated TIME control")
+		}
+		d.result.TimeShiftCentiseconds += decodeBcd(d.data[d.pos])
+		d.pos++
+	case 0x28:
+		d.pos += 5
+	}
+	return nil
+}
+
+func decodeBcd(n byte) int {
+	return (int(n)>>4)*10 + int(n&0x0F)
+}
+
+func (d *decoder) newline() {
+	d.flushRun()
+	d.cur = append(d.cur, '\\', 'N')
+}
+
+func (d *decoder) emitRune(r rune) {
+	d.cur = append(d.cur, r)
+}
+
+func (d *decoder) flushRun() {
+	if len(d.cur) == 0 {
+		return
+	}
+	d.runs = append(d.runs, Run{Text: string(d.cur), Color: d.color, Size: d.size})
+	d.cur = nil
+}
+
+// joinRuns concatenates runs into a single string with ASS inline
+// override tags (e.g. "{\c&Hbbggrr&}", "{\fs..}") inserted at style
+// changes, matching the tags Run.Color/Run.Size describe.
+//
+// Run.Text can itself contain a literal '{' or '}' (reachable through
+// the plain Alphanumeric G-set), which would otherwise be indistinguishable
+// from an override tag delimiter to a consumer like
+// subwriter.parseASSMarkup. escapeBraces substitutes the fullwidth
+// lookalikes so the tag stream stays unambiguous; Result.Runs still
+// carries the original, unescaped text for callers that work with
+// styled runs directly instead of the joined markup string.
+func joinRuns(runs []Run) string {
+	var out []rune
+	for _, r := range runs {
+		if r.Color != "" {
+			out = append(out, []rune(fmt.Sprintf("{\\c&H%s&}", r.Color))...)
+		}
+		if r.Size != 0 {
+			out = append(out, []rune(fmt.Sprintf("{\\fs%d}", int(r.Size*100)))...)
+		}
+		out = append(out, []rune(escapeBraces(r.Text))...)
+	}
+	return string(out)
+}
+
+// escapeBraces replaces literal curly braces with their fullwidth
+// lookalikes so they can't be mistaken for an ASS override tag
+// delimiter when embedded in joinRuns's output.
+func escapeBraces(text string) string {
+	if !strings.ContainsAny(text, "{}") {
+		return text
+	}
+	replacer := strings.NewReplacer("{", "｛", "}", "｝")
+	return replacer.Replace(text)
+}
+
+// decodeAlphanumeric maps the ARIB "Alphanumeric" G-set, which is JIS
+// X 0201 Roman: identical to ASCII except for two code points.
+func decodeAlphanumeric(b byte) rune {
+	switch b {
+	case 0x5C:
+		return '¥'
+	case 0x7E:
+		return '‾'
+	default:
+		return rune(b)
+	}
+}
+
+type kuten struct {
+	row, cell byte
+}
+
+func lookupKuten(table map[byte]rune, cell byte) rune {
+	if r, ok := table[cell]; ok {
+		return r
+	}
+	return '�'
+}
+
+// ssColors are the bare BGR hex values for the eight C1 foreground
+// color controls BKF..WHF, in order. [B24] Table 7-3.
+var ssColors = [8]string{
+	"000000", // BKF black
+	"0000FF", // RDF red
+	"00FF00", // GRF green
+	"00FFFF", // YLF yellow
+	"FF0000", // BLF blue
+	"FF00FF", // MGF magenta
+	"FFFF00", // CNF cyan
+	"FFFFFF", // WHF white
+}
+
+// colControl maps a subset of COL direct-color parameter bytes
+// (0x48-0x4F map onto the same eight colors as ssColors) to an ASS
+// override; other values select a CLUT entry we don't track.
+func colControl(b byte) (string, bool) {
+	if b >= 0x48 && b <= 0x4F {
+		return ssColors[b-0x48], true
+	}
+	return "", false
+}
@@ -0,0 +1,155 @@
+package aribb24
+
+import "testing"
+
+// These fixtures are hand-constructed byte sequences following the
+// control/escape code layout from [B24] 8.2-8.3, not captures from a
+// real TS file: the sandbox this package was written in has no sample
+// recordings available. They exercise the same code paths a real
+// caption statement would.
+func TestDecode(t *testing.T) {
+	cases := []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{
+			name: "kanji from initial G0",
+			data: []byte{0x30, 0x21}, // row 16 cell 1: 亜
+			want: "亜",
+		},
+		{
+			name: "unmapped kanji falls back to replacement rune",
+			data: []byte{0x7D, 0x21}, // row 93, beyond kanjiTable's coverage
+			want: "�",
+		},
+		{
+			name: "LS1/LS0 locking shift into and out of G1 alphanumeric",
+			data: []byte{0x0E, 0x41, 0x0F, 0x30, 0x21}, // LS1 'A' LS0 亜
+			want: "A亜",
+		},
+		{
+			name: "hiragana via GR default invocation",
+			data: []byte{0xA1}, // GR cell 0x21: ぁ
+			want: "ぁ",
+		},
+		{
+			name: "SS3 single shift into G3 katakana for one character",
+			data: []byte{0x1D, 0x21, 0x30, 0x21}, // SS3 ァ, then GL stays kanji: 亜
+			want: "ァ亜",
+		},
+		{
+			name: "space and newline controls",
+			data: []byte{0x20, 0x0A},
+			want: " \\N",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := Decode(c.data)
+			if err != nil {
+				t.Fatalf("Decode(%X) returned error: %v", c.data, err)
+			}
+			if got != c.want {
+				t.Errorf("Decode(%X) = %q, want %q", c.data, got, c.want)
+			}
+		})
+	}
+}
+
+func TestDecode_LiteralBracesAreEscaped(t *testing.T) {
+	// '{' and '}' are ordinary Alphanumeric G-set code points, but
+	// joinRuns uses those bytes as ASS override tag delimiters; a
+	// literal brace in decoded text must not be emitted as-is.
+	data := []byte{0x0E, 0x7B, 0x7D, 0x0F, 0x30, 0x21} // LS1 '{' '}' LS0 亜
+	got, err := Decode(data)
+	if err != nil {
+		t.Fatalf("Decode returned error: %v", err)
+	}
+	want := "｛｝亜"
+	if got != want {
+		t.Errorf("Decode = %q, want %q", got, want)
+	}
+}
+
+func TestDecodeChar_DRCS1ByteWidth(t *testing.T) {
+	// ESC 0x29 0x41 designates G1 as DRCS-1 (single byte per character);
+	// LS1/LS0 then bracket a single DRCS byte and a following kanji
+	// character, which must not be desynchronized by the DRCS advance.
+	data := []byte{0x1B, 0x29, 0x41, 0x0E, 0x21, 0x0F, 0x30, 0x21}
+	got, err := Decode(data)
+	if err != nil {
+		t.Fatalf("Decode returned error: %v", err)
+	}
+	want := DrcsFallback + "亜"
+	if got != want {
+		t.Errorf("Decode = %q, want %q", got, want)
+	}
+}
+
+func TestDecodeChar_DRCS0TwoByteWidth(t *testing.T) {
+	// ESC 0x24 0x40 designates G0 as DRCS-0 (two bytes per character).
+	// A single two-byte DRCS-0 character followed by nothing else must
+	// consume exactly both bytes, not desync onto the second byte as a
+	// new character.
+	data := []byte{0x1B, 0x24, 0x40, 0x21, 0x22}
+	got, err := Decode(data)
+	if err != nil {
+		t.Fatalf("Decode returned error: %v", err)
+	}
+	if got != DrcsFallback {
+		t.Errorf("Decode = %q, want exactly one %q", got, DrcsFallback)
+	}
+}
+
+func TestDecodeWithStyle_ColorRun(t *testing.T) {
+	// 亜, then BKF (foreground black) + LS1, then 'A' in the new style.
+	data := []byte{0x30, 0x21, 0x80, 0x0E, 0x41}
+	result, err := DecodeWithStyle(data)
+	if err != nil {
+		t.Fatalf("DecodeWithStyle returned error: %v", err)
+	}
+	if len(result.Runs) != 2 {
+		t.Fatalf("got %d runs, want 2: %+v", len(result.Runs), result.Runs)
+	}
+	if result.Runs[0].Text != "亜" || result.Runs[0].Color != "" {
+		t.Errorf("first run = %+v, want {Text:亜 Color:\"\"}", result.Runs[0])
+	}
+	if result.Runs[1].Text != "A" || result.Runs[1].Color != "000000" {
+		t.Errorf("second run = %+v, want {Text:A Color:000000}", result.Runs[1])
+	}
+	wantText := "亜{\\c&H000000&}A"
+	if result.Text != wantText {
+		t.Errorf("Text = %q, want %q", result.Text, wantText)
+	}
+}
+
+func TestDecodeWithStyle_TimeControl(t *testing.T) {
+	// TIME control, group 0x20 (relative wait), BCD 0x15 -> 15 centiseconds.
+	data := []byte{0x9D, 0x20, 0x15}
+	result, err := DecodeWithStyle(data)
+	if err != nil {
+		t.Fatalf("DecodeWithStyle returned error: %v", err)
+	}
+	if result.TimeShiftCentiseconds != 15 {
+		t.Errorf("TimeShiftCentiseconds = %d, want 15", result.TimeShiftCentiseconds)
+	}
+}
+
+func TestDecode_TruncatedCharacterIsError(t *testing.T) {
+	cases := []struct {
+		name string
+		data []byte
+	}{
+		{"truncated kanji", []byte{0x30}},
+		{"truncated DRCS-0 character", []byte{0x1B, 0x24, 0x40, 0x21}},
+		{"truncated escape sequence", []byte{0x1B}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if _, err := Decode(c.data); err == nil {
+				t.Errorf("Decode(%X) = nil error, want an error", c.data)
+			}
+		})
+	}
+}
@@ -0,0 +1,6942 @@
+package aribb24
+
+// hiraganaTable maps the GL/GR cell byte (0x21-0x73, masked to 7 bits)
+// of the JIS X 0208 row 4 hiragana block to its Unicode code point.
+// [B24] 8.2, referencing JIS X 0208 row 4.
+var hiraganaTable = map[byte]rune{
+	0x21: 'ぁ', 0x22: 'あ', 0x23: 'ぃ', 0x24: 'い', 0x25: 'ぅ', 0x26: 'う',
+	0x27: 'ぇ', 0x28: 'え', 0x29: 'ぉ', 0x2A: 'お', 0x2B: 'か', 0x2C: 'が',
+	0x2D: 'き', 0x2E: 'ぎ', 0x2F: 'く', 0x30: 'ぐ', 0x31: 'け', 0x32: 'げ',
+	0x33: 'こ', 0x34: 'ご', 0x35: 'さ', 0x36: 'ざ', 0x37: 'し', 0x38: 'じ',
+	0x39: 'す', 0x3A: 'ず', 0x3B: 'せ', 0x3C: 'ぜ', 0x3D: 'そ', 0x3E: 'ぞ',
+	0x3F: 'た', 0x40: 'だ', 0x41: 'ち', 0x42: 'ぢ', 0x43: 'っ', 0x44: 'つ',
+	0x45: 'づ', 0x46: 'て', 0x47: 'で', 0x48: 'と', 0x49: 'ど', 0x4A: 'な',
+	0x4B: 'に', 0x4C: 'ぬ', 0x4D: 'ね', 0x4E: 'の', 0x4F: 'は', 0x50: 'ば',
+	0x51: 'ぱ', 0x52: 'ひ', 0x53: 'び', 0x54: 'ぴ', 0x55: 'ふ', 0x56: 'ぶ',
+	0x57: 'ぷ', 0x58: 'へ', 0x59: 'べ', 0x5A: 'ぺ', 0x5B: 'ほ', 0x5C: 'ぼ',
+	0x5D: 'ぽ', 0x5E: 'ま', 0x5F: 'み', 0x60: 'む', 0x61: 'め', 0x62: 'も',
+	0x63: 'ゃ', 0x64: 'や', 0x65: 'ゅ', 0x66: 'ゆ', 0x67: 'ょ', 0x68: 'よ',
+	0x69: 'ら', 0x6A: 'り', 0x6B: 'る', 0x6C: 'れ', 0x6D: 'ろ', 0x6E: 'ゎ',
+	0x6F: 'わ', 0x70: 'ゐ', 0x71: 'ゑ', 0x72: 'を', 0x73: 'ん',
+}
+
+// katakanaTable maps the GL/GR cell byte of the JIS X 0208 row 5
+// katakana block to its Unicode code point.
+var katakanaTable = map[byte]rune{
+	0x21: 'ァ', 0x22: 'ア', 0x23: 'ィ', 0x24: 'イ', 0x25: 'ゥ', 0x26: 'ウ',
+	0x27: 'ェ', 0x28: 'エ', 0x29: 'ォ', 0x2A: 'オ', 0x2B: 'カ', 0x2C: 'ガ',
+	0x2D: 'キ', 0x2E: 'ギ', 0x2F: 'ク', 0x30: 'グ', 0x31: 'ケ', 0x32: 'ゲ',
+	0x33: 'コ', 0x34: 'ゴ', 0x35: 'サ', 0x36: 'ザ', 0x37: 'シ', 0x38: 'ジ',
+	0x39: 'ス', 0x3A: 'ズ', 0x3B: 'セ', 0x3C: 'ゼ', 0x3D: 'ソ', 0x3E: 'ゾ',
+	0x3F: 'タ', 0x40: 'ダ', 0x41: 'チ', 0x42: 'ヂ', 0x43: 'ッ', 0x44: 'ツ',
+	0x45: 'ヅ', 0x46: 'テ', 0x47: 'デ', 0x48: 'ト', 0x49: 'ド', 0x4A: 'ナ',
+	0x4B: 'ニ', 0x4C: 'ヌ', 0x4D: 'ネ', 0x4E: 'ノ', 0x4F: 'ハ', 0x50: 'バ',
+	0x51: 'パ', 0x52: 'ヒ', 0x53: 'ビ', 0x54: 'ピ', 0x55: 'フ', 0x56: 'ブ',
+	0x57: 'プ', 0x58: 'ヘ', 0x59: 'ベ', 0x5A: 'ペ', 0x5B: 'ホ', 0x5C: 'ボ',
+	0x5D: 'ポ', 0x5E: 'マ', 0x5F: 'ミ', 0x60: 'ム', 0x61: 'メ', 0x62: 'モ',
+	0x63: 'ャ', 0x64: 'ヤ', 0x65: 'ュ', 0x66: 'ユ', 0x67: 'ョ', 0x68: 'ヨ',
+	0x69: 'ラ', 0x6A: 'リ', 0x6B: 'ル', 0x6C: 'レ', 0x6D: 'ロ', 0x6E: 'ヮ',
+	0x6F: 'ワ', 0x70: 'ヰ', 0x71: 'ヱ', 0x72: 'ヲ', 0x73: 'ン', 0x74: 'ヴ',
+	0x77: '・', 0x78: 'ー',
+}
+
+// kanjiTable maps (row, cell) pairs - the two GL bytes of a double-byte
+// character, each masked to 7 bits - to their Unicode code point, for
+// the JIS X 0208 two-byte graphic character set as commonly extended
+// in practice: symbol rows 1-3 and 13, level 1 and level 2 kanji rows
+// 16-84, and the NEC-selected IBM extension kanji in rows 89-92. Rows
+// 4 and 5 (hiragana/katakana) are omitted here since those charsets
+// are decoded through hiraganaTable/katakanaTable instead; excluding
+// them avoids two sources of truth for the same characters. This
+// table was generated from golang.org/x/text/encoding/japanese's
+// ISO-2022-JP tables rather than hand-entered, to avoid the
+// transcription errors a hand-picked subset risks. JIS X 0213 plane 2
+// and ARIB STD-B24's own caption-specific extension rows are still
+// not covered; unmapped positions fall back to the U+FFFD replacement
+// rune in decodeKanji.
+var kanjiTable = map[kuten]rune{
+	{0x21, 0x21}: '\u3000',
+	{0x21, 0x22}: '、',
+	{0x21, 0x23}: '。',
+	{0x21, 0x24}: '，',
+	{0x21, 0x25}: '．',
+	{0x21, 0x26}: '・',
+	{0x21, 0x27}: '：',
+	{0x21, 0x28}: '；',
+	{0x21, 0x29}: '？',
+	{0x21, 0x2A}: '！',
+	{0x21, 0x2B}: '゛',
+	{0x21, 0x2C}: '゜',
+	{0x21, 0x2E}: '｀',
+	{0x21, 0x30}: '＾',
+	{0x21, 0x31}: '￣',
+	{0x21, 0x32}: '＿',
+	{0x21, 0x33}: 'ヽ',
+	{0x21, 0x34}: 'ヾ',
+	{0x21, 0x35}: 'ゝ',
+	{0x21, 0x36}: 'ゞ',
+	{0x21, 0x37}: '〃',
+	{0x21, 0x38}: '仝',
+	{0x21, 0x39}: '々',
+	{0x21, 0x3A}: '〆',
+	{0x21, 0x3B}: '〇',
+	{0x21, 0x3C}: 'ー',
+	{0x21, 0x3F}: '／',
+	{0x21, 0x40}: '＼',
+	{0x21, 0x41}: '～',
+	{0x21, 0x43}: '｜',
+	{0x21, 0x4A}: '（',
+	{0x21, 0x4B}: '）',
+	{0x21, 0x4C}: '〔',
+	{0x21, 0x4D}: '〕',
+	{0x21, 0x4E}: '［',
+	{0x21, 0x4F}: '］',
+	{0x21, 0x50}: '｛',
+	{0x21, 0x51}: '｝',
+	{0x21, 0x52}: '〈',
+	{0x21, 0x53}: '〉',
+	{0x21, 0x54}: '《',
+	{0x21, 0x55}: '》',
+	{0x21, 0x56}: '「',
+	{0x21, 0x57}: '」',
+	{0x21, 0x58}: '『',
+	{0x21, 0x59}: '』',
+	{0x21, 0x5A}: '【',
+	{0x21, 0x5B}: '】',
+	{0x21, 0x5C}: '＋',
+	{0x21, 0x5D}: '－',
+	{0x21, 0x61}: '＝',
+	{0x21, 0x63}: '＜',
+	{0x21, 0x64}: '＞',
+	{0x21, 0x6F}: '￥',
+	{0x21, 0x70}: '＄',
+	{0x21, 0x71}: '￠',
+	{0x21, 0x72}: '￡',
+	{0x21, 0x73}: '％',
+	{0x21, 0x74}: '＃',
+	{0x21, 0x75}: '＆',
+	{0x21, 0x76}: '＊',
+	{0x21, 0x77}: '＠',
+	{0x22, 0x29}: '〒',
+	{0x22, 0x2E}: '〓',
+	{0x22, 0x4C}: '￢',
+	{0x23, 0x30}: '０',
+	{0x23, 0x31}: '１',
+	{0x23, 0x32}: '２',
+	{0x23, 0x33}: '３',
+	{0x23, 0x34}: '４',
+	{0x23, 0x35}: '５',
+	{0x23, 0x36}: '６',
+	{0x23, 0x37}: '７',
+	{0x23, 0x38}: '８',
+	{0x23, 0x39}: '９',
+	{0x23, 0x41}: 'Ａ',
+	{0x23, 0x42}: 'Ｂ',
+	{0x23, 0x43}: 'Ｃ',
+	{0x23, 0x44}: 'Ｄ',
+	{0x23, 0x45}: 'Ｅ',
+	{0x23, 0x46}: 'Ｆ',
+	{0x23, 0x47}: 'Ｇ',
+	{0x23, 0x48}: 'Ｈ',
+	{0x23, 0x49}: 'Ｉ',
+	{0x23, 0x4A}: 'Ｊ',
+	{0x23, 0x4B}: 'Ｋ',
+	{0x23, 0x4C}: 'Ｌ',
+	{0x23, 0x4D}: 'Ｍ',
+	{0x23, 0x4E}: 'Ｎ',
+	{0x23, 0x4F}: 'Ｏ',
+	{0x23, 0x50}: 'Ｐ',
+	{0x23, 0x51}: 'Ｑ',
+	{0x23, 0x52}: 'Ｒ',
+	{0x23, 0x53}: 'Ｓ',
+	{0x23, 0x54}: 'Ｔ',
+	{0x23, 0x55}: 'Ｕ',
+	{0x23, 0x56}: 'Ｖ',
+	{0x23, 0x57}: 'Ｗ',
+	{0x23, 0x58}: 'Ｘ',
+	{0x23, 0x59}: 'Ｙ',
+	{0x23, 0x5A}: 'Ｚ',
+	{0x23, 0x61}: 'ａ',
+	{0x23, 0x62}: 'ｂ',
+	{0x23, 0x63}: 'ｃ',
+	{0x23, 0x64}: 'ｄ',
+	{0x23, 0x65}: 'ｅ',
+	{0x23, 0x66}: 'ｆ',
+	{0x23, 0x67}: 'ｇ',
+	{0x23, 0x68}: 'ｈ',
+	{0x23, 0x69}: 'ｉ',
+	{0x23, 0x6A}: 'ｊ',
+	{0x23, 0x6B}: 'ｋ',
+	{0x23, 0x6C}: 'ｌ',
+	{0x23, 0x6D}: 'ｍ',
+	{0x23, 0x6E}: 'ｎ',
+	{0x23, 0x6F}: 'ｏ',
+	{0x23, 0x70}: 'ｐ',
+	{0x23, 0x71}: 'ｑ',
+	{0x23, 0x72}: 'ｒ',
+	{0x23, 0x73}: 'ｓ',
+	{0x23, 0x74}: 'ｔ',
+	{0x23, 0x75}: 'ｕ',
+	{0x23, 0x76}: 'ｖ',
+	{0x23, 0x77}: 'ｗ',
+	{0x23, 0x78}: 'ｘ',
+	{0x23, 0x79}: 'ｙ',
+	{0x23, 0x7A}: 'ｚ',
+	{0x2D, 0x40}: '㍉',
+	{0x2D, 0x41}: '㌔',
+	{0x2D, 0x42}: '㌢',
+	{0x2D, 0x43}: '㍍',
+	{0x2D, 0x44}: '㌘',
+	{0x2D, 0x45}: '㌧',
+	{0x2D, 0x46}: '㌃',
+	{0x2D, 0x47}: '㌶',
+	{0x2D, 0x48}: '㍑',
+	{0x2D, 0x49}: '㍗',
+	{0x2D, 0x4A}: '㌍',
+	{0x2D, 0x4B}: '㌦',
+	{0x2D, 0x4C}: '㌣',
+	{0x2D, 0x4D}: '㌫',
+	{0x2D, 0x4E}: '㍊',
+	{0x2D, 0x4F}: '㌻',
+	{0x2D, 0x50}: '㎜',
+	{0x2D, 0x51}: '㎝',
+	{0x2D, 0x52}: '㎞',
+	{0x2D, 0x53}: '㎎',
+	{0x2D, 0x54}: '㎏',
+	{0x2D, 0x55}: '㏄',
+	{0x2D, 0x56}: '㎡',
+	{0x2D, 0x5F}: '㍻',
+	{0x2D, 0x60}: '〝',
+	{0x2D, 0x61}: '〟',
+	{0x2D, 0x63}: '㏍',
+	{0x2D, 0x65}: '㊤',
+	{0x2D, 0x66}: '㊥',
+	{0x2D, 0x67}: '㊦',
+	{0x2D, 0x68}: '㊧',
+	{0x2D, 0x69}: '㊨',
+	{0x2D, 0x6A}: '㈱',
+	{0x2D, 0x6B}: '㈲',
+	{0x2D, 0x6C}: '㈹',
+	{0x2D, 0x6D}: '㍾',
+	{0x2D, 0x6E}: '㍽',
+	{0x2D, 0x6F}: '㍼',
+	{0x30, 0x21}: '亜',
+	{0x30, 0x22}: '唖',
+	{0x30, 0x23}: '娃',
+	{0x30, 0x24}: '阿',
+	{0x30, 0x25}: '哀',
+	{0x30, 0x26}: '愛',
+	{0x30, 0x27}: '挨',
+	{0x30, 0x28}: '姶',
+	{0x30, 0x29}: '逢',
+	{0x30, 0x2A}: '葵',
+	{0x30, 0x2B}: '茜',
+	{0x30, 0x2C}: '穐',
+	{0x30, 0x2D}: '悪',
+	{0x30, 0x2E}: '握',
+	{0x30, 0x2F}: '渥',
+	{0x30, 0x30}: '旭',
+	{0x30, 0x31}: '葦',
+	{0x30, 0x32}: '芦',
+	{0x30, 0x33}: '鯵',
+	{0x30, 0x34}: '梓',
+	{0x30, 0x35}: '圧',
+	{0x30, 0x36}: '斡',
+	{0x30, 0x37}: '扱',
+	{0x30, 0x38}: '宛',
+	{0x30, 0x39}: '姐',
+	{0x30, 0x3A}: '虻',
+	{0x30, 0x3B}: '飴',
+	{0x30, 0x3C}: '絢',
+	{0x30, 0x3D}: '綾',
+	{0x30, 0x3E}: '鮎',
+	{0x30, 0x3F}: '或',
+	{0x30, 0x40}: '粟',
+	{0x30, 0x41}: '袷',
+	{0x30, 0x42}: '安',
+	{0x30, 0x43}: '庵',
+	{0x30, 0x44}: '按',
+	{0x30, 0x45}: '暗',
+	{0x30, 0x46}: '案',
+	{0x30, 0x47}: '闇',
+	{0x30, 0x48}: '鞍',
+	{0x30, 0x49}: '杏',
+	{0x30, 0x4A}: '以',
+	{0x30, 0x4B}: '伊',
+	{0x30, 0x4C}: '位',
+	{0x30, 0x4D}: '依',
+	{0x30, 0x4E}: '偉',
+	{0x30, 0x4F}: '囲',
+	{0x30, 0x50}: '夷',
+	{0x30, 0x51}: '委',
+	{0x30, 0x52}: '威',
+	{0x30, 0x53}: '尉',
+	{0x30, 0x54}: '惟',
+	{0x30, 0x55}: '意',
+	{0x30, 0x56}: '慰',
+	{0x30, 0x57}: '易',
+	{0x30, 0x58}: '椅',
+	{0x30, 0x59}: '為',
+	{0x30, 0x5A}: '畏',
+	{0x30, 0x5B}: '異',
+	{0x30, 0x5C}: '移',
+	{0x30, 0x5D}: '維',
+	{0x30, 0x5E}: '緯',
+	{0x30, 0x5F}: '胃',
+	{0x30, 0x60}: '萎',
+	{0x30, 0x61}: '衣',
+	{0x30, 0x62}: '謂',
+	{0x30, 0x63}: '違',
+	{0x30, 0x64}: '遺',
+	{0x30, 0x65}: '医',
+	{0x30, 0x66}: '井',
+	{0x30, 0x67}: '亥',
+	{0x30, 0x68}: '域',
+	{0x30, 0x69}: '育',
+	{0x30, 0x6A}: '郁',
+	{0x30, 0x6B}: '磯',
+	{0x30, 0x6C}: '一',
+	{0x30, 0x6D}: '壱',
+	{0x30, 0x6E}: '溢',
+	{0x30, 0x6F}: '逸',
+	{0x30, 0x70}: '稲',
+	{0x30, 0x71}: '茨',
+	{0x30, 0x72}: '芋',
+	{0x30, 0x73}: '鰯',
+	{0x30, 0x74}: '允',
+	{0x30, 0x75}: '印',
+	{0x30, 0x76}: '咽',
+	{0x30, 0x77}: '員',
+	{0x30, 0x78}: '因',
+	{0x30, 0x79}: '姻',
+	{0x30, 0x7A}: '引',
+	{0x30, 0x7B}: '飲',
+	{0x30, 0x7C}: '淫',
+	{0x30, 0x7D}: '胤',
+	{0x30, 0x7E}: '蔭',
+	{0x31, 0x21}: '院',
+	{0x31, 0x22}: '陰',
+	{0x31, 0x23}: '隠',
+	{0x31, 0x24}: '韻',
+	{0x31, 0x25}: '吋',
+	{0x31, 0x26}: '右',
+	{0x31, 0x27}: '宇',
+	{0x31, 0x28}: '烏',
+	{0x31, 0x29}: '羽',
+	{0x31, 0x2A}: '迂',
+	{0x31, 0x2B}: '雨',
+	{0x31, 0x2C}: '卯',
+	{0x31, 0x2D}: '鵜',
+	{0x31, 0x2E}: '窺',
+	{0x31, 0x2F}: '丑',
+	{0x31, 0x30}: '碓',
+	{0x31, 0x31}: '臼',
+	{0x31, 0x32}: '渦',
+	{0x31, 0x33}: '嘘',
+	{0x31, 0x34}: '唄',
+	{0x31, 0x35}: '欝',
+	{0x31, 0x36}: '蔚',
+	{0x31, 0x37}: '鰻',
+	{0x31, 0x38}: '姥',
+	{0x31, 0x39}: '厩',
+	{0x31, 0x3A}: '浦',
+	{0x31, 0x3B}: '瓜',
+	{0x31, 0x3C}: '閏',
+	{0x31, 0x3D}: '噂',
+	{0x31, 0x3E}: '云',
+	{0x31, 0x3F}: '運',
+	{0x31, 0x40}: '雲',
+	{0x31, 0x41}: '荏',
+	{0x31, 0x42}: '餌',
+	{0x31, 0x43}: '叡',
+	{0x31, 0x44}: '営',
+	{0x31, 0x45}: '嬰',
+	{0x31, 0x46}: '影',
+	{0x31, 0x47}: '映',
+	{0x31, 0x48}: '曳',
+	{0x31, 0x49}: '栄',
+	{0x31, 0x4A}: '永',
+	{0x31, 0x4B}: '泳',
+	{0x31, 0x4C}: '洩',
+	{0x31, 0x4D}: '瑛',
+	{0x31, 0x4E}: '盈',
+	{0x31, 0x4F}: '穎',
+	{0x31, 0x50}: '頴',
+	{0x31, 0x51}: '英',
+	{0x31, 0x52}: '衛',
+	{0x31, 0x53}: '詠',
+	{0x31, 0x54}: '鋭',
+	{0x31, 0x55}: '液',
+	{0x31, 0x56}: '疫',
+	{0x31, 0x57}: '益',
+	{0x31, 0x58}: '駅',
+	{0x31, 0x59}: '悦',
+	{0x31, 0x5A}: '謁',
+	{0x31, 0x5B}: '越',
+	{0x31, 0x5C}: '閲',
+	{0x31, 0x5D}: '榎',
+	{0x31, 0x5E}: '厭',
+	{0x31, 0x5F}: '円',
+	{0x31, 0x60}: '園',
+	{0x31, 0x61}: '堰',
+	{0x31, 0x62}: '奄',
+	{0x31, 0x63}: '宴',
+	{0x31, 0x64}: '延',
+	{0x31, 0x65}: '怨',
+	{0x31, 0x66}: '掩',
+	{0x31, 0x67}: '援',
+	{0x31, 0x68}: '沿',
+	{0x31, 0x69}: '演',
+	{0x31, 0x6A}: '炎',
+	{0x31, 0x6B}: '焔',
+	{0x31, 0x6C}: '煙',
+	{0x31, 0x6D}: '燕',
+	{0x31, 0x6E}: '猿',
+	{0x31, 0x6F}: '縁',
+	{0x31, 0x70}: '艶',
+	{0x31, 0x71}: '苑',
+	{0x31, 0x72}: '薗',
+	{0x31, 0x73}: '遠',
+	{0x31, 0x74}: '鉛',
+	{0x31, 0x75}: '鴛',
+	{0x31, 0x76}: '塩',
+	{0x31, 0x77}: '於',
+	{0x31, 0x78}: '汚',
+	{0x31, 0x79}: '甥',
+	{0x31, 0x7A}: '凹',
+	{0x31, 0x7B}: '央',
+	{0x31, 0x7C}: '奥',
+	{0x31, 0x7D}: '往',
+	{0x31, 0x7E}: '応',
+	{0x32, 0x21}: '押',
+	{0x32, 0x22}: '旺',
+	{0x32, 0x23}: '横',
+	{0x32, 0x24}: '欧',
+	{0x32, 0x25}: '殴',
+	{0x32, 0x26}: '王',
+	{0x32, 0x27}: '翁',
+	{0x32, 0x28}: '襖',
+	{0x32, 0x29}: '鴬',
+	{0x32, 0x2A}: '鴎',
+	{0x32, 0x2B}: '黄',
+	{0x32, 0x2C}: '岡',
+	{0x32, 0x2D}: '沖',
+	{0x32, 0x2E}: '荻',
+	{0x32, 0x2F}: '億',
+	{0x32, 0x30}: '屋',
+	{0x32, 0x31}: '憶',
+	{0x32, 0x32}: '臆',
+	{0x32, 0x33}: '桶',
+	{0x32, 0x34}: '牡',
+	{0x32, 0x35}: '乙',
+	{0x32, 0x36}: '俺',
+	{0x32, 0x37}: '卸',
+	{0x32, 0x38}: '恩',
+	{0x32, 0x39}: '温',
+	{0x32, 0x3A}: '穏',
+	{0x32, 0x3B}: '音',
+	{0x32, 0x3C}: '下',
+	{0x32, 0x3D}: '化',
+	{0x32, 0x3E}: '仮',
+	{0x32, 0x3F}: '何',
+	{0x32, 0x40}: '伽',
+	{0x32, 0x41}: '価',
+	{0x32, 0x42}: '佳',
+	{0x32, 0x43}: '加',
+	{0x32, 0x44}: '可',
+	{0x32, 0x45}: '嘉',
+	{0x32, 0x46}: '夏',
+	{0x32, 0x47}: '嫁',
+	{0x32, 0x48}: '家',
+	{0x32, 0x49}: '寡',
+	{0x32, 0x4A}: '科',
+	{0x32, 0x4B}: '暇',
+	{0x32, 0x4C}: '果',
+	{0x32, 0x4D}: '架',
+	{0x32, 0x4E}: '歌',
+	{0x32, 0x4F}: '河',
+	{0x32, 0x50}: '火',
+	{0x32, 0x51}: '珂',
+	{0x32, 0x52}: '禍',
+	{0x32, 0x53}: '禾',
+	{0x32, 0x54}: '稼',
+	{0x32, 0x55}: '箇',
+	{0x32, 0x56}: '花',
+	{0x32, 0x57}: '苛',
+	{0x32, 0x58}: '茄',
+	{0x32, 0x59}: '荷',
+	{0x32, 0x5A}: '華',
+	{0x32, 0x5B}: '菓',
+	{0x32, 0x5C}: '蝦',
+	{0x32, 0x5D}: '課',
+	{0x32, 0x5E}: '嘩',
+	{0x32, 0x5F}: '貨',
+	{0x32, 0x60}: '迦',
+	{0x32, 0x61}: '過',
+	{0x32, 0x62}: '霞',
+	{0x32, 0x63}: '蚊',
+	{0x32, 0x64}: '俄',
+	{0x32, 0x65}: '峨',
+	{0x32, 0x66}: '我',
+	{0x32, 0x67}: '牙',
+	{0x32, 0x68}: '画',
+	{0x32, 0x69}: '臥',
+	{0x32, 0x6A}: '芽',
+	{0x32, 0x6B}: '蛾',
+	{0x32, 0x6C}: '賀',
+	{0x32, 0x6D}: '雅',
+	{0x32, 0x6E}: '餓',
+	{0x32, 0x6F}: '駕',
+	{0x32, 0x70}: '介',
+	{0x32, 0x71}: '会',
+	{0x32, 0x72}: '解',
+	{0x32, 0x73}: '回',
+	{0x32, 0x74}: '塊',
+	{0x32, 0x75}: '壊',
+	{0x32, 0x76}: '廻',
+	{0x32, 0x77}: '快',
+	{0x32, 0x78}: '怪',
+	{0x32, 0x79}: '悔',
+	{0x32, 0x7A}: '恢',
+	{0x32, 0x7B}: '懐',
+	{0x32, 0x7C}: '戒',
+	{0x32, 0x7D}: '拐',
+	{0x32, 0x7E}: '改',
+	{0x33, 0x21}: '魁',
+	{0x33, 0x22}: '晦',
+	{0x33, 0x23}: '械',
+	{0x33, 0x24}: '海',
+	{0x33, 0x25}: '灰',
+	{0x33, 0x26}: '界',
+	{0x33, 0x27}: '皆',
+	{0x33, 0x28}: '絵',
+	{0x33, 0x29}: '芥',
+	{0x33, 0x2A}: '蟹',
+	{0x33, 0x2B}: '開',
+	{0x33, 0x2C}: '階',
+	{0x33, 0x2D}: '貝',
+	{0x33, 0x2E}: '凱',
+	{0x33, 0x2F}: '劾',
+	{0x33, 0x30}: '外',
+	{0x33, 0x31}: '咳',
+	{0x33, 0x32}: '害',
+	{0x33, 0x33}: '崖',
+	{0x33, 0x34}: '慨',
+	{0x33, 0x35}: '概',
+	{0x33, 0x36}: '涯',
+	{0x33, 0x37}: '碍',
+	{0x33, 0x38}: '蓋',
+	{0x33, 0x39}: '街',
+	{0x33, 0x3A}: '該',
+	{0x33, 0x3B}: '鎧',
+	{0x33, 0x3C}: '骸',
+	{0x33, 0x3D}: '浬',
+	{0x33, 0x3E}: '馨',
+	{0x33, 0x3F}: '蛙',
+	{0x33, 0x40}: '垣',
+	{0x33, 0x41}: '柿',
+	{0x33, 0x42}: '蛎',
+	{0x33, 0x43}: '鈎',
+	{0x33, 0x44}: '劃',
+	{0x33, 0x45}: '嚇',
+	{0x33, 0x46}: '各',
+	{0x33, 0x47}: '廓',
+	{0x33, 0x48}: '拡',
+	{0x33, 0x49}: '撹',
+	{0x33, 0x4A}: '格',
+	{0x33, 0x4B}: '核',
+	{0x33, 0x4C}: '殻',
+	{0x33, 0x4D}: '獲',
+	{0x33, 0x4E}: '確',
+	{0x33, 0x4F}: '穫',
+	{0x33, 0x50}: '覚',
+	{0x33, 0x51}: '角',
+	{0x33, 0x52}: '赫',
+	{0x33, 0x53}: '較',
+	{0x33, 0x54}: '郭',
+	{0x33, 0x55}: '閣',
+	{0x33, 0x56}: '隔',
+	{0x33, 0x57}: '革',
+	{0x33, 0x58}: '学',
+	{0x33, 0x59}: '岳',
+	{0x33, 0x5A}: '楽',
+	{0x33, 0x5B}: '額',
+	{0x33, 0x5C}: '顎',
+	{0x33, 0x5D}: '掛',
+	{0x33, 0x5E}: '笠',
+	{0x33, 0x5F}: '樫',
+	{0x33, 0x60}: '橿',
+	{0x33, 0x61}: '梶',
+	{0x33, 0x62}: '鰍',
+	{0x33, 0x63}: '潟',
+	{0x33, 0x64}: '割',
+	{0x33, 0x65}: '喝',
+	{0x33, 0x66}: '恰',
+	{0x33, 0x67}: '括',
+	{0x33, 0x68}: '活',
+	{0x33, 0x69}: '渇',
+	{0x33, 0x6A}: '滑',
+	{0x33, 0x6B}: '葛',
+	{0x33, 0x6C}: '褐',
+	{0x33, 0x6D}: '轄',
+	{0x33, 0x6E}: '且',
+	{0x33, 0x6F}: '鰹',
+	{0x33, 0x70}: '叶',
+	{0x33, 0x71}: '椛',
+	{0x33, 0x72}: '樺',
+	{0x33, 0x73}: '鞄',
+	{0x33, 0x74}: '株',
+	{0x33, 0x75}: '兜',
+	{0x33, 0x76}: '竃',
+	{0x33, 0x77}: '蒲',
+	{0x33, 0x78}: '釜',
+	{0x33, 0x79}: '鎌',
+	{0x33, 0x7A}: '噛',
+	{0x33, 0x7B}: '鴨',
+	{0x33, 0x7C}: '栢',
+	{0x33, 0x7D}: '茅',
+	{0x33, 0x7E}: '萱',
+	{0x34, 0x21}: '粥',
+	{0x34, 0x22}: '刈',
+	{0x34, 0x23}: '苅',
+	{0x34, 0x24}: '瓦',
+	{0x34, 0x25}: '乾',
+	{0x34, 0x26}: '侃',
+	{0x34, 0x27}: '冠',
+	{0x34, 0x28}: '寒',
+	{0x34, 0x29}: '刊',
+	{0x34, 0x2A}: '勘',
+	{0x34, 0x2B}: '勧',
+	{0x34, 0x2C}: '巻',
+	{0x34, 0x2D}: '喚',
+	{0x34, 0x2E}: '堪',
+	{0x34, 0x2F}: '姦',
+	{0x34, 0x30}: '完',
+	{0x34, 0x31}: '官',
+	{0x34, 0x32}: '寛',
+	{0x34, 0x33}: '干',
+	{0x34, 0x34}: '幹',
+	{0x34, 0x35}: '患',
+	{0x34, 0x36}: '感',
+	{0x34, 0x37}: '慣',
+	{0x34, 0x38}: '憾',
+	{0x34, 0x39}: '換',
+	{0x34, 0x3A}: '敢',
+	{0x34, 0x3B}: '柑',
+	{0x34, 0x3C}: '桓',
+	{0x34, 0x3D}: '棺',
+	{0x34, 0x3E}: '款',
+	{0x34, 0x3F}: '歓',
+	{0x34, 0x40}: '汗',
+	{0x34, 0x41}: '漢',
+	{0x34, 0x42}: '澗',
+	{0x34, 0x43}: '潅',
+	{0x34, 0x44}: '環',
+	{0x34, 0x45}: '甘',
+	{0x34, 0x46}: '監',
+	{0x34, 0x47}: '看',
+	{0x34, 0x48}: '竿',
+	{0x34, 0x49}: '管',
+	{0x34, 0x4A}: '簡',
+	{0x34, 0x4B}: '緩',
+	{0x34, 0x4C}: '缶',
+	{0x34, 0x4D}: '翰',
+	{0x34, 0x4E}: '肝',
+	{0x34, 0x4F}: '艦',
+	{0x34, 0x50}: '莞',
+	{0x34, 0x51}: '観',
+	{0x34, 0x52}: '諌',
+	{0x34, 0x53}: '貫',
+	{0x34, 0x54}: '還',
+	{0x34, 0x55}: '鑑',
+	{0x34, 0x56}: '間',
+	{0x34, 0x57}: '閑',
+	{0x34, 0x58}: '関',
+	{0x34, 0x59}: '陥',
+	{0x34, 0x5A}: '韓',
+	{0x34, 0x5B}: '館',
+	{0x34, 0x5C}: '舘',
+	{0x34, 0x5D}: '丸',
+	{0x34, 0x5E}: '含',
+	{0x34, 0x5F}: '岸',
+	{0x34, 0x60}: '巌',
+	{0x34, 0x61}: '玩',
+	{0x34, 0x62}: '癌',
+	{0x34, 0x63}: '眼',
+	{0x34, 0x64}: '岩',
+	{0x34, 0x65}: '翫',
+	{0x34, 0x66}: '贋',
+	{0x34, 0x67}: '雁',
+	{0x34, 0x68}: '頑',
+	{0x34, 0x69}: '顔',
+	{0x34, 0x6A}: '願',
+	{0x34, 0x6B}: '企',
+	{0x34, 0x6C}: '伎',
+	{0x34, 0x6D}: '危',
+	{0x34, 0x6E}: '喜',
+	{0x34, 0x6F}: '器',
+	{0x34, 0x70}: '基',
+	{0x34, 0x71}: '奇',
+	{0x34, 0x72}: '嬉',
+	{0x34, 0x73}: '寄',
+	{0x34, 0x74}: '岐',
+	{0x34, 0x75}: '希',
+	{0x34, 0x76}: '幾',
+	{0x34, 0x77}: '忌',
+	{0x34, 0x78}: '揮',
+	{0x34, 0x79}: '机',
+	{0x34, 0x7A}: '旗',
+	{0x34, 0x7B}: '既',
+	{0x34, 0x7C}: '期',
+	{0x34, 0x7D}: '棋',
+	{0x34, 0x7E}: '棄',
+	{0x35, 0x21}: '機',
+	{0x35, 0x22}: '帰',
+	{0x35, 0x23}: '毅',
+	{0x35, 0x24}: '気',
+	{0x35, 0x25}: '汽',
+	{0x35, 0x26}: '畿',
+	{0x35, 0x27}: '祈',
+	{0x35, 0x28}: '季',
+	{0x35, 0x29}: '稀',
+	{0x35, 0x2A}: '紀',
+	{0x35, 0x2B}: '徽',
+	{0x35, 0x2C}: '規',
+	{0x35, 0x2D}: '記',
+	{0x35, 0x2E}: '貴',
+	{0x35, 0x2F}: '起',
+	{0x35, 0x30}: '軌',
+	{0x35, 0x31}: '輝',
+	{0x35, 0x32}: '飢',
+	{0x35, 0x33}: '騎',
+	{0x35, 0x34}: '鬼',
+	{0x35, 0x35}: '亀',
+	{0x35, 0x36}: '偽',
+	{0x35, 0x37}: '儀',
+	{0x35, 0x38}: '妓',
+	{0x35, 0x39}: '宜',
+	{0x35, 0x3A}: '戯',
+	{0x35, 0x3B}: '技',
+	{0x35, 0x3C}: '擬',
+	{0x35, 0x3D}: '欺',
+	{0x35, 0x3E}: '犠',
+	{0x35, 0x3F}: '疑',
+	{0x35, 0x40}: '祇',
+	{0x35, 0x41}: '義',
+	{0x35, 0x42}: '蟻',
+	{0x35, 0x43}: '誼',
+	{0x35, 0x44}: '議',
+	{0x35, 0x45}: '掬',
+	{0x35, 0x46}: '菊',
+	{0x35, 0x47}: '鞠',
+	{0x35, 0x48}: '吉',
+	{0x35, 0x49}: '吃',
+	{0x35, 0x4A}: '喫',
+	{0x35, 0x4B}: '桔',
+	{0x35, 0x4C}: '橘',
+	{0x35, 0x4D}: '詰',
+	{0x35, 0x4E}: '砧',
+	{0x35, 0x4F}: '杵',
+	{0x35, 0x50}: '黍',
+	{0x35, 0x51}: '却',
+	{0x35, 0x52}: '客',
+	{0x35, 0x53}: '脚',
+	{0x35, 0x54}: '虐',
+	{0x35, 0x55}: '逆',
+	{0x35, 0x56}: '丘',
+	{0x35, 0x57}: '久',
+	{0x35, 0x58}: '仇',
+	{0x35, 0x59}: '休',
+	{0x35, 0x5A}: '及',
+	{0x35, 0x5B}: '吸',
+	{0x35, 0x5C}: '宮',
+	{0x35, 0x5D}: '弓',
+	{0x35, 0x5E}: '急',
+	{0x35, 0x5F}: '救',
+	{0x35, 0x60}: '朽',
+	{0x35, 0x61}: '求',
+	{0x35, 0x62}: '汲',
+	{0x35, 0x63}: '泣',
+	{0x35, 0x64}: '灸',
+	{0x35, 0x65}: '球',
+	{0x35, 0x66}: '究',
+	{0x35, 0x67}: '窮',
+	{0x35, 0x68}: '笈',
+	{0x35, 0x69}: '級',
+	{0x35, 0x6A}: '糾',
+	{0x35, 0x6B}: '給',
+	{0x35, 0x6C}: '旧',
+	{0x35, 0x6D}: '牛',
+	{0x35, 0x6E}: '去',
+	{0x35, 0x6F}: '居',
+	{0x35, 0x70}: '巨',
+	{0x35, 0x71}: '拒',
+	{0x35, 0x72}: '拠',
+	{0x35, 0x73}: '挙',
+	{0x35, 0x74}: '渠',
+	{0x35, 0x75}: '虚',
+	{0x35, 0x76}: '許',
+	{0x35, 0x77}: '距',
+	{0x35, 0x78}: '鋸',
+	{0x35, 0x79}: '漁',
+	{0x35, 0x7A}: '禦',
+	{0x35, 0x7B}: '魚',
+	{0x35, 0x7C}: '亨',
+	{0x35, 0x7D}: '享',
+	{0x35, 0x7E}: '京',
+	{0x36, 0x21}: '供',
+	{0x36, 0x22}: '侠',
+	{0x36, 0x23}: '僑',
+	{0x36, 0x24}: '兇',
+	{0x36, 0x25}: '競',
+	{0x36, 0x26}: '共',
+	{0x36, 0x27}: '凶',
+	{0x36, 0x28}: '協',
+	{0x36, 0x29}: '匡',
+	{0x36, 0x2A}: '卿',
+	{0x36, 0x2B}: '叫',
+	{0x36, 0x2C}: '喬',
+	{0x36, 0x2D}: '境',
+	{0x36, 0x2E}: '峡',
+	{0x36, 0x2F}: '強',
+	{0x36, 0x30}: '彊',
+	{0x36, 0x31}: '怯',
+	{0x36, 0x32}: '恐',
+	{0x36, 0x33}: '恭',
+	{0x36, 0x34}: '挟',
+	{0x36, 0x35}: '教',
+	{0x36, 0x36}: '橋',
+	{0x36, 0x37}: '況',
+	{0x36, 0x38}: '狂',
+	{0x36, 0x39}: '狭',
+	{0x36, 0x3A}: '矯',
+	{0x36, 0x3B}: '胸',
+	{0x36, 0x3C}: '脅',
+	{0x36, 0x3D}: '興',
+	{0x36, 0x3E}: '蕎',
+	{0x36, 0x3F}: '郷',
+	{0x36, 0x40}: '鏡',
+	{0x36, 0x41}: '響',
+	{0x36, 0x42}: '饗',
+	{0x36, 0x43}: '驚',
+	{0x36, 0x44}: '仰',
+	{0x36, 0x45}: '凝',
+	{0x36, 0x46}: '尭',
+	{0x36, 0x47}: '暁',
+	{0x36, 0x48}: '業',
+	{0x36, 0x49}: '局',
+	{0x36, 0x4A}: '曲',
+	{0x36, 0x4B}: '極',
+	{0x36, 0x4C}: '玉',
+	{0x36, 0x4D}: '桐',
+	{0x36, 0x4E}: '粁',
+	{0x36, 0x4F}: '僅',
+	{0x36, 0x50}: '勤',
+	{0x36, 0x51}: '均',
+	{0x36, 0x52}: '巾',
+	{0x36, 0x53}: '錦',
+	{0x36, 0x54}: '斤',
+	{0x36, 0x55}: '欣',
+	{0x36, 0x56}: '欽',
+	{0x36, 0x57}: '琴',
+	{0x36, 0x58}: '禁',
+	{0x36, 0x59}: '禽',
+	{0x36, 0x5A}: '筋',
+	{0x36, 0x5B}: '緊',
+	{0x36, 0x5C}: '芹',
+	{0x36, 0x5D}: '菌',
+	{0x36, 0x5E}: '衿',
+	{0x36, 0x5F}: '襟',
+	{0x36, 0x60}: '謹',
+	{0x36, 0x61}: '近',
+	{0x36, 0x62}: '金',
+	{0x36, 0x63}: '吟',
+	{0x36, 0x64}: '銀',
+	{0x36, 0x65}: '九',
+	{0x36, 0x66}: '倶',
+	{0x36, 0x67}: '句',
+	{0x36, 0x68}: '区',
+	{0x36, 0x69}: '狗',
+	{0x36, 0x6A}: '玖',
+	{0x36, 0x6B}: '矩',
+	{0x36, 0x6C}: '苦',
+	{0x36, 0x6D}: '躯',
+	{0x36, 0x6E}: '駆',
+	{0x36, 0x6F}: '駈',
+	{0x36, 0x70}: '駒',
+	{0x36, 0x71}: '具',
+	{0x36, 0x72}: '愚',
+	{0x36, 0x73}: '虞',
+	{0x36, 0x74}: '喰',
+	{0x36, 0x75}: '空',
+	{0x36, 0x76}: '偶',
+	{0x36, 0x77}: '寓',
+	{0x36, 0x78}: '遇',
+	{0x36, 0x79}: '隅',
+	{0x36, 0x7A}: '串',
+	{0x36, 0x7B}: '櫛',
+	{0x36, 0x7C}: '釧',
+	{0x36, 0x7D}: '屑',
+	{0x36, 0x7E}: '屈',
+	{0x37, 0x21}: '掘',
+	{0x37, 0x22}: '窟',
+	{0x37, 0x23}: '沓',
+	{0x37, 0x24}: '靴',
+	{0x37, 0x25}: '轡',
+	{0x37, 0x26}: '窪',
+	{0x37, 0x27}: '熊',
+	{0x37, 0x28}: '隈',
+	{0x37, 0x29}: '粂',
+	{0x37, 0x2A}: '栗',
+	{0x37, 0x2B}: '繰',
+	{0x37, 0x2C}: '桑',
+	{0x37, 0x2D}: '鍬',
+	{0x37, 0x2E}: '勲',
+	{0x37, 0x2F}: '君',
+	{0x37, 0x30}: '薫',
+	{0x37, 0x31}: '訓',
+	{0x37, 0x32}: '群',
+	{0x37, 0x33}: '軍',
+	{0x37, 0x34}: '郡',
+	{0x37, 0x35}: '卦',
+	{0x37, 0x36}: '袈',
+	{0x37, 0x37}: '祁',
+	{0x37, 0x38}: '係',
+	{0x37, 0x39}: '傾',
+	{0x37, 0x3A}: '刑',
+	{0x37, 0x3B}: '兄',
+	{0x37, 0x3C}: '啓',
+	{0x37, 0x3D}: '圭',
+	{0x37, 0x3E}: '珪',
+	{0x37, 0x3F}: '型',
+	{0x37, 0x40}: '契',
+	{0x37, 0x41}: '形',
+	{0x37, 0x42}: '径',
+	{0x37, 0x43}: '恵',
+	{0x37, 0x44}: '慶',
+	{0x37, 0x45}: '慧',
+	{0x37, 0x46}: '憩',
+	{0x37, 0x47}: '掲',
+	{0x37, 0x48}: '携',
+	{0x37, 0x49}: '敬',
+	{0x37, 0x4A}: '景',
+	{0x37, 0x4B}: '桂',
+	{0x37, 0x4C}: '渓',
+	{0x37, 0x4D}: '畦',
+	{0x37, 0x4E}: '稽',
+	{0x37, 0x4F}: '系',
+	{0x37, 0x50}: '経',
+	{0x37, 0x51}: '継',
+	{0x37, 0x52}: '繋',
+	{0x37, 0x53}: '罫',
+	{0x37, 0x54}: '茎',
+	{0x37, 0x55}: '荊',
+	{0x37, 0x56}: '蛍',
+	{0x37, 0x57}: '計',
+	{0x37, 0x58}: '詣',
+	{0x37, 0x59}: '警',
+	{0x37, 0x5A}: '軽',
+	{0x37, 0x5B}: '頚',
+	{0x37, 0x5C}: '鶏',
+	{0x37, 0x5D}: '芸',
+	{0x37, 0x5E}: '迎',
+	{0x37, 0x5F}: '鯨',
+	{0x37, 0x60}: '劇',
+	{0x37, 0x61}: '戟',
+	{0x37, 0x62}: '撃',
+	{0x37, 0x63}: '激',
+	{0x37, 0x64}: '隙',
+	{0x37, 0x65}: '桁',
+	{0x37, 0x66}: '傑',
+	{0x37, 0x67}: '欠',
+	{0x37, 0x68}: '決',
+	{0x37, 0x69}: '潔',
+	{0x37, 0x6A}: '穴',
+	{0x37, 0x6B}: '結',
+	{0x37, 0x6C}: '血',
+	{0x37, 0x6D}: '訣',
+	{0x37, 0x6E}: '月',
+	{0x37, 0x6F}: '件',
+	{0x37, 0x70}: '倹',
+	{0x37, 0x71}: '倦',
+	{0x37, 0x72}: '健',
+	{0x37, 0x73}: '兼',
+	{0x37, 0x74}: '券',
+	{0x37, 0x75}: '剣',
+	{0x37, 0x76}: '喧',
+	{0x37, 0x77}: '圏',
+	{0x37, 0x78}: '堅',
+	{0x37, 0x79}: '嫌',
+	{0x37, 0x7A}: '建',
+	{0x37, 0x7B}: '憲',
+	{0x37, 0x7C}: '懸',
+	{0x37, 0x7D}: '拳',
+	{0x37, 0x7E}: '捲',
+	{0x38, 0x21}: '検',
+	{0x38, 0x22}: '権',
+	{0x38, 0x23}: '牽',
+	{0x38, 0x24}: '犬',
+	{0x38, 0x25}: '献',
+	{0x38, 0x26}: '研',
+	{0x38, 0x27}: '硯',
+	{0x38, 0x28}: '絹',
+	{0x38, 0x29}: '県',
+	{0x38, 0x2A}: '肩',
+	{0x38, 0x2B}: '見',
+	{0x38, 0x2C}: '謙',
+	{0x38, 0x2D}: '賢',
+	{0x38, 0x2E}: '軒',
+	{0x38, 0x2F}: '遣',
+	{0x38, 0x30}: '鍵',
+	{0x38, 0x31}: '険',
+	{0x38, 0x32}: '顕',
+	{0x38, 0x33}: '験',
+	{0x38, 0x34}: '鹸',
+	{0x38, 0x35}: '元',
+	{0x38, 0x36}: '原',
+	{0x38, 0x37}: '厳',
+	{0x38, 0x38}: '幻',
+	{0x38, 0x39}: '弦',
+	{0x38, 0x3A}: '減',
+	{0x38, 0x3B}: '源',
+	{0x38, 0x3C}: '玄',
+	{0x38, 0x3D}: '現',
+	{0x38, 0x3E}: '絃',
+	{0x38, 0x3F}: '舷',
+	{0x38, 0x40}: '言',
+	{0x38, 0x41}: '諺',
+	{0x38, 0x42}: '限',
+	{0x38, 0x43}: '乎',
+	{0x38, 0x44}: '個',
+	{0x38, 0x45}: '古',
+	{0x38, 0x46}: '呼',
+	{0x38, 0x47}: '固',
+	{0x38, 0x48}: '姑',
+	{0x38, 0x49}: '孤',
+	{0x38, 0x4A}: '己',
+	{0x38, 0x4B}: '庫',
+	{0x38, 0x4C}: '弧',
+	{0x38, 0x4D}: '戸',
+	{0x38, 0x4E}: '故',
+	{0x38, 0x4F}: '枯',
+	{0x38, 0x50}: '湖',
+	{0x38, 0x51}: '狐',
+	{0x38, 0x52}: '糊',
+	{0x38, 0x53}: '袴',
+	{0x38, 0x54}: '股',
+	{0x38, 0x55}: '胡',
+	{0x38, 0x56}: '菰',
+	{0x38, 0x57}: '虎',
+	{0x38, 0x58}: '誇',
+	{0x38, 0x59}: '跨',
+	{0x38, 0x5A}: '鈷',
+	{0x38, 0x5B}: '雇',
+	{0x38, 0x5C}: '顧',
+	{0x38, 0x5D}: '鼓',
+	{0x38, 0x5E}: '五',
+	{0x38, 0x5F}: '互',
+	{0x38, 0x60}: '伍',
+	{0x38, 0x61}: '午',
+	{0x38, 0x62}: '呉',
+	{0x38, 0x63}: '吾',
+	{0x38, 0x64}: '娯',
+	{0x38, 0x65}: '後',
+	{0x38, 0x66}: '御',
+	{0x38, 0x67}: '悟',
+	{0x38, 0x68}: '梧',
+	{0x38, 0x69}: '檎',
+	{0x38, 0x6A}: '瑚',
+	{0x38, 0x6B}: '碁',
+	{0x38, 0x6C}: '語',
+	{0x38, 0x6D}: '誤',
+	{0x38, 0x6E}: '護',
+	{0x38, 0x6F}: '醐',
+	{0x38, 0x70}: '乞',
+	{0x38, 0x71}: '鯉',
+	{0x38, 0x72}: '交',
+	{0x38, 0x73}: '佼',
+	{0x38, 0x74}: '侯',
+	{0x38, 0x75}: '候',
+	{0x38, 0x76}: '倖',
+	{0x38, 0x77}: '光',
+	{0x38, 0x78}: '公',
+	{0x38, 0x79}: '功',
+	{0x38, 0x7A}: '効',
+	{0x38, 0x7B}: '勾',
+	{0x38, 0x7C}: '厚',
+	{0x38, 0x7D}: '口',
+	{0x38, 0x7E}: '向',
+	{0x39, 0x21}: '后',
+	{0x39, 0x22}: '喉',
+	{0x39, 0x23}: '坑',
+	{0x39, 0x24}: '垢',
+	{0x39, 0x25}: '好',
+	{0x39, 0x26}: '孔',
+	{0x39, 0x27}: '孝',
+	{0x39, 0x28}: '宏',
+	{0x39, 0x29}: '工',
+	{0x39, 0x2A}: '巧',
+	{0x39, 0x2B}: '巷',
+	{0x39, 0x2C}: '幸',
+	{0x39, 0x2D}: '広',
+	{0x39, 0x2E}: '庚',
+	{0x39, 0x2F}: '康',
+	{0x39, 0x30}: '弘',
+	{0x39, 0x31}: '恒',
+	{0x39, 0x32}: '慌',
+	{0x39, 0x33}: '抗',
+	{0x39, 0x34}: '拘',
+	{0x39, 0x35}: '控',
+	{0x39, 0x36}: '攻',
+	{0x39, 0x37}: '昂',
+	{0x39, 0x38}: '晃',
+	{0x39, 0x39}: '更',
+	{0x39, 0x3A}: '杭',
+	{0x39, 0x3B}: '校',
+	{0x39, 0x3C}: '梗',
+	{0x39, 0x3D}: '構',
+	{0x39, 0x3E}: '江',
+	{0x39, 0x3F}: '洪',
+	{0x39, 0x40}: '浩',
+	{0x39, 0x41}: '港',
+	{0x39, 0x42}: '溝',
+	{0x39, 0x43}: '甲',
+	{0x39, 0x44}: '皇',
+	{0x39, 0x45}: '硬',
+	{0x39, 0x46}: '稿',
+	{0x39, 0x47}: '糠',
+	{0x39, 0x48}: '紅',
+	{0x39, 0x49}: '紘',
+	{0x39, 0x4A}: '絞',
+	{0x39, 0x4B}: '綱',
+	{0x39, 0x4C}: '耕',
+	{0x39, 0x4D}: '考',
+	{0x39, 0x4E}: '肯',
+	{0x39, 0x4F}: '肱',
+	{0x39, 0x50}: '腔',
+	{0x39, 0x51}: '膏',
+	{0x39, 0x52}: '航',
+	{0x39, 0x53}: '荒',
+	{0x39, 0x54}: '行',
+	{0x39, 0x55}: '衡',
+	{0x39, 0x56}: '講',
+	{0x39, 0x57}: '貢',
+	{0x39, 0x58}: '購',
+	{0x39, 0x59}: '郊',
+	{0x39, 0x5A}: '酵',
+	{0x39, 0x5B}: '鉱',
+	{0x39, 0x5C}: '砿',
+	{0x39, 0x5D}: '鋼',
+	{0x39, 0x5E}: '閤',
+	{0x39, 0x5F}: '降',
+	{0x39, 0x60}: '項',
+	{0x39, 0x61}: '香',
+	{0x39, 0x62}: '高',
+	{0x39, 0x63}: '鴻',
+	{0x39, 0x64}: '剛',
+	{0x39, 0x65}: '劫',
+	{0x39, 0x66}: '号',
+	{0x39, 0x67}: '合',
+	{0x39, 0x68}: '壕',
+	{0x39, 0x69}: '拷',
+	{0x39, 0x6A}: '濠',
+	{0x39, 0x6B}: '豪',
+	{0x39, 0x6C}: '轟',
+	{0x39, 0x6D}: '麹',
+	{0x39, 0x6E}: '克',
+	{0x39, 0x6F}: '刻',
+	{0x39, 0x70}: '告',
+	{0x39, 0x71}: '国',
+	{0x39, 0x72}: '穀',
+	{0x39, 0x73}: '酷',
+	{0x39, 0x74}: '鵠',
+	{0x39, 0x75}: '黒',
+	{0x39, 0x76}: '獄',
+	{0x39, 0x77}: '漉',
+	{0x39, 0x78}: '腰',
+	{0x39, 0x79}: '甑',
+	{0x39, 0x7A}: '忽',
+	{0x39, 0x7B}: '惚',
+	{0x39, 0x7C}: '骨',
+	{0x39, 0x7D}: '狛',
+	{0x39, 0x7E}: '込',
+	{0x3A, 0x21}: '此',
+	{0x3A, 0x22}: '頃',
+	{0x3A, 0x23}: '今',
+	{0x3A, 0x24}: '困',
+	{0x3A, 0x25}: '坤',
+	{0x3A, 0x26}: '墾',
+	{0x3A, 0x27}: '婚',
+	{0x3A, 0x28}: '恨',
+	{0x3A, 0x29}: '懇',
+	{0x3A, 0x2A}: '昏',
+	{0x3A, 0x2B}: '昆',
+	{0x3A, 0x2C}: '根',
+	{0x3A, 0x2D}: '梱',
+	{0x3A, 0x2E}: '混',
+	{0x3A, 0x2F}: '痕',
+	{0x3A, 0x30}: '紺',
+	{0x3A, 0x31}: '艮',
+	{0x3A, 0x32}: '魂',
+	{0x3A, 0x33}: '些',
+	{0x3A, 0x34}: '佐',
+	{0x3A, 0x35}: '叉',
+	{0x3A, 0x36}: '唆',
+	{0x3A, 0x37}: '嵯',
+	{0x3A, 0x38}: '左',
+	{0x3A, 0x39}: '差',
+	{0x3A, 0x3A}: '査',
+	{0x3A, 0x3B}: '沙',
+	{0x3A, 0x3C}: '瑳',
+	{0x3A, 0x3D}: '砂',
+	{0x3A, 0x3E}: '詐',
+	{0x3A, 0x3F}: '鎖',
+	{0x3A, 0x40}: '裟',
+	{0x3A, 0x41}: '坐',
+	{0x3A, 0x42}: '座',
+	{0x3A, 0x43}: '挫',
+	{0x3A, 0x44}: '債',
+	{0x3A, 0x45}: '催',
+	{0x3A, 0x46}: '再',
+	{0x3A, 0x47}: '最',
+	{0x3A, 0x48}: '哉',
+	{0x3A, 0x49}: '塞',
+	{0x3A, 0x4A}: '妻',
+	{0x3A, 0x4B}: '宰',
+	{0x3A, 0x4C}: '彩',
+	{0x3A, 0x4D}: '才',
+	{0x3A, 0x4E}: '採',
+	{0x3A, 0x4F}: '栽',
+	{0x3A, 0x50}: '歳',
+	{0x3A, 0x51}: '済',
+	{0x3A, 0x52}: '災',
+	{0x3A, 0x53}: '采',
+	{0x3A, 0x54}: '犀',
+	{0x3A, 0x55}: '砕',
+	{0x3A, 0x56}: '砦',
+	{0x3A, 0x57}: '祭',
+	{0x3A, 0x58}: '斎',
+	{0x3A, 0x59}: '細',
+	{0x3A, 0x5A}: '菜',
+	{0x3A, 0x5B}: '裁',
+	{0x3A, 0x5C}: '載',
+	{0x3A, 0x5D}: '際',
+	{0x3A, 0x5E}: '剤',
+	{0x3A, 0x5F}: '在',
+	{0x3A, 0x60}: '材',
+	{0x3A, 0x61}: '罪',
+	{0x3A, 0x62}: '財',
+	{0x3A, 0x63}: '冴',
+	{0x3A, 0x64}: '坂',
+	{0x3A, 0x65}: '阪',
+	{0x3A, 0x66}: '堺',
+	{0x3A, 0x67}: '榊',
+	{0x3A, 0x68}: '肴',
+	{0x3A, 0x69}: '咲',
+	{0x3A, 0x6A}: '崎',
+	{0x3A, 0x6B}: '埼',
+	{0x3A, 0x6C}: '碕',
+	{0x3A, 0x6D}: '鷺',
+	{0x3A, 0x6E}: '作',
+	{0x3A, 0x6F}: '削',
+	{0x3A, 0x70}: '咋',
+	{0x3A, 0x71}: '搾',
+	{0x3A, 0x72}: '昨',
+	{0x3A, 0x73}: '朔',
+	{0x3A, 0x74}: '柵',
+	{0x3A, 0x75}: '窄',
+	{0x3A, 0x76}: '策',
+	{0x3A, 0x77}: '索',
+	{0x3A, 0x78}: '錯',
+	{0x3A, 0x79}: '桜',
+	{0x3A, 0x7A}: '鮭',
+	{0x3A, 0x7B}: '笹',
+	{0x3A, 0x7C}: '匙',
+	{0x3A, 0x7D}: '冊',
+	{0x3A, 0x7E}: '刷',
+	{0x3B, 0x21}: '察',
+	{0x3B, 0x22}: '拶',
+	{0x3B, 0x23}: '撮',
+	{0x3B, 0x24}: '擦',
+	{0x3B, 0x25}: '札',
+	{0x3B, 0x26}: '殺',
+	{0x3B, 0x27}: '薩',
+	{0x3B, 0x28}: '雑',
+	{0x3B, 0x29}: '皐',
+	{0x3B, 0x2A}: '鯖',
+	{0x3B, 0x2B}: '捌',
+	{0x3B, 0x2C}: '錆',
+	{0x3B, 0x2D}: '鮫',
+	{0x3B, 0x2E}: '皿',
+	{0x3B, 0x2F}: '晒',
+	{0x3B, 0x30}: '三',
+	{0x3B, 0x31}: '傘',
+	{0x3B, 0x32}: '参',
+	{0x3B, 0x33}: '山',
+	{0x3B, 0x34}: '惨',
+	{0x3B, 0x35}: '撒',
+	{0x3B, 0x36}: '散',
+	{0x3B, 0x37}: '桟',
+	{0x3B, 0x38}: '燦',
+	{0x3B, 0x39}: '珊',
+	{0x3B, 0x3A}: '産',
+	{0x3B, 0x3B}: '算',
+	{0x3B, 0x3C}: '纂',
+	{0x3B, 0x3D}: '蚕',
+	{0x3B, 0x3E}: '讃',
+	{0x3B, 0x3F}: '賛',
+	{0x3B, 0x40}: '酸',
+	{0x3B, 0x41}: '餐',
+	{0x3B, 0x42}: '斬',
+	{0x3B, 0x43}: '暫',
+	{0x3B, 0x44}: '残',
+	{0x3B, 0x45}: '仕',
+	{0x3B, 0x46}: '仔',
+	{0x3B, 0x47}: '伺',
+	{0x3B, 0x48}: '使',
+	{0x3B, 0x49}: '刺',
+	{0x3B, 0x4A}: '司',
+	{0x3B, 0x4B}: '史',
+	{0x3B, 0x4C}: '嗣',
+	{0x3B, 0x4D}: '四',
+	{0x3B, 0x4E}: '士',
+	{0x3B, 0x4F}: '始',
+	{0x3B, 0x50}: '姉',
+	{0x3B, 0x51}: '姿',
+	{0x3B, 0x52}: '子',
+	{0x3B, 0x53}: '屍',
+	{0x3B, 0x54}: '市',
+	{0x3B, 0x55}: '師',
+	{0x3B, 0x56}: '志',
+	{0x3B, 0x57}: '思',
+	{0x3B, 0x58}: '指',
+	{0x3B, 0x59}: '支',
+	{0x3B, 0x5A}: '孜',
+	{0x3B, 0x5B}: '斯',
+	{0x3B, 0x5C}: '施',
+	{0x3B, 0x5D}: '旨',
+	{0x3B, 0x5E}: '枝',
+	{0x3B, 0x5F}: '止',
+	{0x3B, 0x60}: '死',
+	{0x3B, 0x61}: '氏',
+	{0x3B, 0x62}: '獅',
+	{0x3B, 0x63}: '祉',
+	{0x3B, 0x64}: '私',
+	{0x3B, 0x65}: '糸',
+	{0x3B, 0x66}: '紙',
+	{0x3B, 0x67}: '紫',
+	{0x3B, 0x68}: '肢',
+	{0x3B, 0x69}: '脂',
+	{0x3B, 0x6A}: '至',
+	{0x3B, 0x6B}: '視',
+	{0x3B, 0x6C}: '詞',
+	{0x3B, 0x6D}: '詩',
+	{0x3B, 0x6E}: '試',
+	{0x3B, 0x6F}: '誌',
+	{0x3B, 0x70}: '諮',
+	{0x3B, 0x71}: '資',
+	{0x3B, 0x72}: '賜',
+	{0x3B, 0x73}: '雌',
+	{0x3B, 0x74}: '飼',
+	{0x3B, 0x75}: '歯',
+	{0x3B, 0x76}: '事',
+	{0x3B, 0x77}: '似',
+	{0x3B, 0x78}: '侍',
+	{0x3B, 0x79}: '児',
+	{0x3B, 0x7A}: '字',
+	{0x3B, 0x7B}: '寺',
+	{0x3B, 0x7C}: '慈',
+	{0x3B, 0x7D}: '持',
+	{0x3B, 0x7E}: '時',
+	{0x3C, 0x21}: '次',
+	{0x3C, 0x22}: '滋',
+	{0x3C, 0x23}: '治',
+	{0x3C, 0x24}: '爾',
+	{0x3C, 0x25}: '璽',
+	{0x3C, 0x26}: '痔',
+	{0x3C, 0x27}: '磁',
+	{0x3C, 0x28}: '示',
+	{0x3C, 0x29}: '而',
+	{0x3C, 0x2A}: '耳',
+	{0x3C, 0x2B}: '自',
+	{0x3C, 0x2C}: '蒔',
+	{0x3C, 0x2D}: '辞',
+	{0x3C, 0x2E}: '汐',
+	{0x3C, 0x2F}: '鹿',
+	{0x3C, 0x30}: '式',
+	{0x3C, 0x31}: '識',
+	{0x3C, 0x32}: '鴫',
+	{0x3C, 0x33}: '竺',
+	{0x3C, 0x34}: '軸',
+	{0x3C, 0x35}: '宍',
+	{0x3C, 0x36}: '雫',
+	{0x3C, 0x37}: '七',
+	{0x3C, 0x38}: '叱',
+	{0x3C, 0x39}: '執',
+	{0x3C, 0x3A}: '失',
+	{0x3C, 0x3B}: '嫉',
+	{0x3C, 0x3C}: '室',
+	{0x3C, 0x3D}: '悉',
+	{0x3C, 0x3E}: '湿',
+	{0x3C, 0x3F}: '漆',
+	{0x3C, 0x40}: '疾',
+	{0x3C, 0x41}: '質',
+	{0x3C, 0x42}: '実',
+	{0x3C, 0x43}: '蔀',
+	{0x3C, 0x44}: '篠',
+	{0x3C, 0x45}: '偲',
+	{0x3C, 0x46}: '柴',
+	{0x3C, 0x47}: '芝',
+	{0x3C, 0x48}: '屡',
+	{0x3C, 0x49}: '蕊',
+	{0x3C, 0x4A}: '縞',
+	{0x3C, 0x4B}: '舎',
+	{0x3C, 0x4C}: '写',
+	{0x3C, 0x4D}: '射',
+	{0x3C, 0x4E}: '捨',
+	{0x3C, 0x4F}: '赦',
+	{0x3C, 0x50}: '斜',
+	{0x3C, 0x51}: '煮',
+	{0x3C, 0x52}: '社',
+	{0x3C, 0x53}: '紗',
+	{0x3C, 0x54}: '者',
+	{0x3C, 0x55}: '謝',
+	{0x3C, 0x56}: '車',
+	{0x3C, 0x57}: '遮',
+	{0x3C, 0x58}: '蛇',
+	{0x3C, 0x59}: '邪',
+	{0x3C, 0x5A}: '借',
+	{0x3C, 0x5B}: '勺',
+	{0x3C, 0x5C}: '尺',
+	{0x3C, 0x5D}: '杓',
+	{0x3C, 0x5E}: '灼',
+	{0x3C, 0x5F}: '爵',
+	{0x3C, 0x60}: '酌',
+	{0x3C, 0x61}: '釈',
+	{0x3C, 0x62}: '錫',
+	{0x3C, 0x63}: '若',
+	{0x3C, 0x64}: '寂',
+	{0x3C, 0x65}: '弱',
+	{0x3C, 0x66}: '惹',
+	{0x3C, 0x67}: '主',
+	{0x3C, 0x68}: '取',
+	{0x3C, 0x69}: '守',
+	{0x3C, 0x6A}: '手',
+	{0x3C, 0x6B}: '朱',
+	{0x3C, 0x6C}: '殊',
+	{0x3C, 0x6D}: '狩',
+	{0x3C, 0x6E}: '珠',
+	{0x3C, 0x6F}: '種',
+	{0x3C, 0x70}: '腫',
+	{0x3C, 0x71}: '趣',
+	{0x3C, 0x72}: '酒',
+	{0x3C, 0x73}: '首',
+	{0x3C, 0x74}: '儒',
+	{0x3C, 0x75}: '受',
+	{0x3C, 0x76}: '呪',
+	{0x3C, 0x77}: '寿',
+	{0x3C, 0x78}: '授',
+	{0x3C, 0x79}: '樹',
+	{0x3C, 0x7A}: '綬',
+	{0x3C, 0x7B}: '需',
+	{0x3C, 0x7C}: '囚',
+	{0x3C, 0x7D}: '収',
+	{0x3C, 0x7E}: '周',
+	{0x3D, 0x21}: '宗',
+	{0x3D, 0x22}: '就',
+	{0x3D, 0x23}: '州',
+	{0x3D, 0x24}: '修',
+	{0x3D, 0x25}: '愁',
+	{0x3D, 0x26}: '拾',
+	{0x3D, 0x27}: '洲',
+	{0x3D, 0x28}: '秀',
+	{0x3D, 0x29}: '秋',
+	{0x3D, 0x2A}: '終',
+	{0x3D, 0x2B}: '繍',
+	{0x3D, 0x2C}: '習',
+	{0x3D, 0x2D}: '臭',
+	{0x3D, 0x2E}: '舟',
+	{0x3D, 0x2F}: '蒐',
+	{0x3D, 0x30}: '衆',
+	{0x3D, 0x31}: '襲',
+	{0x3D, 0x32}: '讐',
+	{0x3D, 0x33}: '蹴',
+	{0x3D, 0x34}: '輯',
+	{0x3D, 0x35}: '週',
+	{0x3D, 0x36}: '酋',
+	{0x3D, 0x37}: '酬',
+	{0x3D, 0x38}: '集',
+	{0x3D, 0x39}: '醜',
+	{0x3D, 0x3A}: '什',
+	{0x3D, 0x3B}: '住',
+	{0x3D, 0x3C}: '充',
+	{0x3D, 0x3D}: '十',
+	{0x3D, 0x3E}: '従',
+	{0x3D, 0x3F}: '戎',
+	{0x3D, 0x40}: '柔',
+	{0x3D, 0x41}: '汁',
+	{0x3D, 0x42}: '渋',
+	{0x3D, 0x43}: '獣',
+	{0x3D, 0x44}: '縦',
+	{0x3D, 0x45}: '重',
+	{0x3D, 0x46}: '銃',
+	{0x3D, 0x47}: '叔',
+	{0x3D, 0x48}: '夙',
+	{0x3D, 0x49}: '宿',
+	{0x3D, 0x4A}: '淑',
+	{0x3D, 0x4B}: '祝',
+	{0x3D, 0x4C}: '縮',
+	{0x3D, 0x4D}: '粛',
+	{0x3D, 0x4E}: '塾',
+	{0x3D, 0x4F}: '熟',
+	{0x3D, 0x50}: '出',
+	{0x3D, 0x51}: '術',
+	{0x3D, 0x52}: '述',
+	{0x3D, 0x53}: '俊',
+	{0x3D, 0x54}: '峻',
+	{0x3D, 0x55}: '春',
+	{0x3D, 0x56}: '瞬',
+	{0x3D, 0x57}: '竣',
+	{0x3D, 0x58}: '舜',
+	{0x3D, 0x59}: '駿',
+	{0x3D, 0x5A}: '准',
+	{0x3D, 0x5B}: '循',
+	{0x3D, 0x5C}: '旬',
+	{0x3D, 0x5D}: '楯',
+	{0x3D, 0x5E}: '殉',
+	{0x3D, 0x5F}: '淳',
+	{0x3D, 0x60}: '準',
+	{0x3D, 0x61}: '潤',
+	{0x3D, 0x62}: '盾',
+	{0x3D, 0x63}: '純',
+	{0x3D, 0x64}: '巡',
+	{0x3D, 0x65}: '遵',
+	{0x3D, 0x66}: '醇',
+	{0x3D, 0x67}: '順',
+	{0x3D, 0x68}: '処',
+	{0x3D, 0x69}: '初',
+	{0x3D, 0x6A}: '所',
+	{0x3D, 0x6B}: '暑',
+	{0x3D, 0x6C}: '曙',
+	{0x3D, 0x6D}: '渚',
+	{0x3D, 0x6E}: '庶',
+	{0x3D, 0x6F}: '緒',
+	{0x3D, 0x70}: '署',
+	{0x3D, 0x71}: '書',
+	{0x3D, 0x72}: '薯',
+	{0x3D, 0x73}: '藷',
+	{0x3D, 0x74}: '諸',
+	{0x3D, 0x75}: '助',
+	{0x3D, 0x76}: '叙',
+	{0x3D, 0x77}: '女',
+	{0x3D, 0x78}: '序',
+	{0x3D, 0x79}: '徐',
+	{0x3D, 0x7A}: '恕',
+	{0x3D, 0x7B}: '鋤',
+	{0x3D, 0x7C}: '除',
+	{0x3D, 0x7D}: '傷',
+	{0x3D, 0x7E}: '償',
+	{0x3E, 0x21}: '勝',
+	{0x3E, 0x22}: '匠',
+	{0x3E, 0x23}: '升',
+	{0x3E, 0x24}: '召',
+	{0x3E, 0x25}: '哨',
+	{0x3E, 0x26}: '商',
+	{0x3E, 0x27}: '唱',
+	{0x3E, 0x28}: '嘗',
+	{0x3E, 0x29}: '奨',
+	{0x3E, 0x2A}: '妾',
+	{0x3E, 0x2B}: '娼',
+	{0x3E, 0x2C}: '宵',
+	{0x3E, 0x2D}: '将',
+	{0x3E, 0x2E}: '小',
+	{0x3E, 0x2F}: '少',
+	{0x3E, 0x30}: '尚',
+	{0x3E, 0x31}: '庄',
+	{0x3E, 0x32}: '床',
+	{0x3E, 0x33}: '廠',
+	{0x3E, 0x34}: '彰',
+	{0x3E, 0x35}: '承',
+	{0x3E, 0x36}: '抄',
+	{0x3E, 0x37}: '招',
+	{0x3E, 0x38}: '掌',
+	{0x3E, 0x39}: '捷',
+	{0x3E, 0x3A}: '昇',
+	{0x3E, 0x3B}: '昌',
+	{0x3E, 0x3C}: '昭',
+	{0x3E, 0x3D}: '晶',
+	{0x3E, 0x3E}: '松',
+	{0x3E, 0x3F}: '梢',
+	{0x3E, 0x40}: '樟',
+	{0x3E, 0x41}: '樵',
+	{0x3E, 0x42}: '沼',
+	{0x3E, 0x43}: '消',
+	{0x3E, 0x44}: '渉',
+	{0x3E, 0x45}: '湘',
+	{0x3E, 0x46}: '焼',
+	{0x3E, 0x47}: '焦',
+	{0x3E, 0x48}: '照',
+	{0x3E, 0x49}: '症',
+	{0x3E, 0x4A}: '省',
+	{0x3E, 0x4B}: '硝',
+	{0x3E, 0x4C}: '礁',
+	{0x3E, 0x4D}: '祥',
+	{0x3E, 0x4E}: '称',
+	{0x3E, 0x4F}: '章',
+	{0x3E, 0x50}: '笑',
+	{0x3E, 0x51}: '粧',
+	{0x3E, 0x52}: '紹',
+	{0x3E, 0x53}: '肖',
+	{0x3E, 0x54}: '菖',
+	{0x3E, 0x55}: '蒋',
+	{0x3E, 0x56}: '蕉',
+	{0x3E, 0x57}: '衝',
+	{0x3E, 0x58}: '裳',
+	{0x3E, 0x59}: '訟',
+	{0x3E, 0x5A}: '証',
+	{0x3E, 0x5B}: '詔',
+	{0x3E, 0x5C}: '詳',
+	{0x3E, 0x5D}: '象',
+	{0x3E, 0x5E}: '賞',
+	{0x3E, 0x5F}: '醤',
+	{0x3E, 0x60}: '鉦',
+	{0x3E, 0x61}: '鍾',
+	{0x3E, 0x62}: '鐘',
+	{0x3E, 0x63}: '障',
+	{0x3E, 0x64}: '鞘',
+	{0x3E, 0x65}: '上',
+	{0x3E, 0x66}: '丈',
+	{0x3E, 0x67}: '丞',
+	{0x3E, 0x68}: '乗',
+	{0x3E, 0x69}: '冗',
+	{0x3E, 0x6A}: '剰',
+	{0x3E, 0x6B}: '城',
+	{0x3E, 0x6C}: '場',
+	{0x3E, 0x6D}: '壌',
+	{0x3E, 0x6E}: '嬢',
+	{0x3E, 0x6F}: '常',
+	{0x3E, 0x70}: '情',
+	{0x3E, 0x71}: '擾',
+	{0x3E, 0x72}: '条',
+	{0x3E, 0x73}: '杖',
+	{0x3E, 0x74}: '浄',
+	{0x3E, 0x75}: '状',
+	{0x3E, 0x76}: '畳',
+	{0x3E, 0x77}: '穣',
+	{0x3E, 0x78}: '蒸',
+	{0x3E, 0x79}: '譲',
+	{0x3E, 0x7A}: '醸',
+	{0x3E, 0x7B}: '錠',
+	{0x3E, 0x7C}: '嘱',
+	{0x3E, 0x7D}: '埴',
+	{0x3E, 0x7E}: '飾',
+	{0x3F, 0x21}: '拭',
+	{0x3F, 0x22}: '植',
+	{0x3F, 0x23}: '殖',
+	{0x3F, 0x24}: '燭',
+	{0x3F, 0x25}: '織',
+	{0x3F, 0x26}: '職',
+	{0x3F, 0x27}: '色',
+	{0x3F, 0x28}: '触',
+	{0x3F, 0x29}: '食',
+	{0x3F, 0x2A}: '蝕',
+	{0x3F, 0x2B}: '辱',
+	{0x3F, 0x2C}: '尻',
+	{0x3F, 0x2D}: '伸',
+	{0x3F, 0x2E}: '信',
+	{0x3F, 0x2F}: '侵',
+	{0x3F, 0x30}: '唇',
+	{0x3F, 0x31}: '娠',
+	{0x3F, 0x32}: '寝',
+	{0x3F, 0x33}: '審',
+	{0x3F, 0x34}: '心',
+	{0x3F, 0x35}: '慎',
+	{0x3F, 0x36}: '振',
+	{0x3F, 0x37}: '新',
+	{0x3F, 0x38}: '晋',
+	{0x3F, 0x39}: '森',
+	{0x3F, 0x3A}: '榛',
+	{0x3F, 0x3B}: '浸',
+	{0x3F, 0x3C}: '深',
+	{0x3F, 0x3D}: '申',
+	{0x3F, 0x3E}: '疹',
+	{0x3F, 0x3F}: '真',
+	{0x3F, 0x40}: '神',
+	{0x3F, 0x41}: '秦',
+	{0x3F, 0x42}: '紳',
+	{0x3F, 0x43}: '臣',
+	{0x3F, 0x44}: '芯',
+	{0x3F, 0x45}: '薪',
+	{0x3F, 0x46}: '親',
+	{0x3F, 0x47}: '診',
+	{0x3F, 0x48}: '身',
+	{0x3F, 0x49}: '辛',
+	{0x3F, 0x4A}: '進',
+	{0x3F, 0x4B}: '針',
+	{0x3F, 0x4C}: '震',
+	{0x3F, 0x4D}: '人',
+	{0x3F, 0x4E}: '仁',
+	{0x3F, 0x4F}: '刃',
+	{0x3F, 0x50}: '塵',
+	{0x3F, 0x51}: '壬',
+	{0x3F, 0x52}: '尋',
+	{0x3F, 0x53}: '甚',
+	{0x3F, 0x54}: '尽',
+	{0x3F, 0x55}: '腎',
+	{0x3F, 0x56}: '訊',
+	{0x3F, 0x57}: '迅',
+	{0x3F, 0x58}: '陣',
+	{0x3F, 0x59}: '靭',
+	{0x3F, 0x5A}: '笥',
+	{0x3F, 0x5B}: '諏',
+	{0x3F, 0x5C}: '須',
+	{0x3F, 0x5D}: '酢',
+	{0x3F, 0x5E}: '図',
+	{0x3F, 0x5F}: '厨',
+	{0x3F, 0x60}: '逗',
+	{0x3F, 0x61}: '吹',
+	{0x3F, 0x62}: '垂',
+	{0x3F, 0x63}: '帥',
+	{0x3F, 0x64}: '推',
+	{0x3F, 0x65}: '水',
+	{0x3F, 0x66}: '炊',
+	{0x3F, 0x67}: '睡',
+	{0x3F, 0x68}: '粋',
+	{0x3F, 0x69}: '翠',
+	{0x3F, 0x6A}: '衰',
+	{0x3F, 0x6B}: '遂',
+	{0x3F, 0x6C}: '酔',
+	{0x3F, 0x6D}: '錐',
+	{0x3F, 0x6E}: '錘',
+	{0x3F, 0x6F}: '随',
+	{0x3F, 0x70}: '瑞',
+	{0x3F, 0x71}: '髄',
+	{0x3F, 0x72}: '崇',
+	{0x3F, 0x73}: '嵩',
+	{0x3F, 0x74}: '数',
+	{0x3F, 0x75}: '枢',
+	{0x3F, 0x76}: '趨',
+	{0x3F, 0x77}: '雛',
+	{0x3F, 0x78}: '据',
+	{0x3F, 0x79}: '杉',
+	{0x3F, 0x7A}: '椙',
+	{0x3F, 0x7B}: '菅',
+	{0x3F, 0x7C}: '頗',
+	{0x3F, 0x7D}: '雀',
+	{0x3F, 0x7E}: '裾',
+	{0x40, 0x21}: '澄',
+	{0x40, 0x22}: '摺',
+	{0x40, 0x23}: '寸',
+	{0x40, 0x24}: '世',
+	{0x40, 0x25}: '瀬',
+	{0x40, 0x26}: '畝',
+	{0x40, 0x27}: '是',
+	{0x40, 0x28}: '凄',
+	{0x40, 0x29}: '制',
+	{0x40, 0x2A}: '勢',
+	{0x40, 0x2B}: '姓',
+	{0x40, 0x2C}: '征',
+	{0x40, 0x2D}: '性',
+	{0x40, 0x2E}: '成',
+	{0x40, 0x2F}: '政',
+	{0x40, 0x30}: '整',
+	{0x40, 0x31}: '星',
+	{0x40, 0x32}: '晴',
+	{0x40, 0x33}: '棲',
+	{0x40, 0x34}: '栖',
+	{0x40, 0x35}: '正',
+	{0x40, 0x36}: '清',
+	{0x40, 0x37}: '牲',
+	{0x40, 0x38}: '生',
+	{0x40, 0x39}: '盛',
+	{0x40, 0x3A}: '精',
+	{0x40, 0x3B}: '聖',
+	{0x40, 0x3C}: '声',
+	{0x40, 0x3D}: '製',
+	{0x40, 0x3E}: '西',
+	{0x40, 0x3F}: '誠',
+	{0x40, 0x40}: '誓',
+	{0x40, 0x41}: '請',
+	{0x40, 0x42}: '逝',
+	{0x40, 0x43}: '醒',
+	{0x40, 0x44}: '青',
+	{0x40, 0x45}: '静',
+	{0x40, 0x46}: '斉',
+	{0x40, 0x47}: '税',
+	{0x40, 0x48}: '脆',
+	{0x40, 0x49}: '隻',
+	{0x40, 0x4A}: '席',
+	{0x40, 0x4B}: '惜',
+	{0x40, 0x4C}: '戚',
+	{0x40, 0x4D}: '斥',
+	{0x40, 0x4E}: '昔',
+	{0x40, 0x4F}: '析',
+	{0x40, 0x50}: '石',
+	{0x40, 0x51}: '積',
+	{0x40, 0x52}: '籍',
+	{0x40, 0x53}: '績',
+	{0x40, 0x54}: '脊',
+	{0x40, 0x55}: '責',
+	{0x40, 0x56}: '赤',
+	{0x40, 0x57}: '跡',
+	{0x40, 0x58}: '蹟',
+	{0x40, 0x59}: '碩',
+	{0x40, 0x5A}: '切',
+	{0x40, 0x5B}: '拙',
+	{0x40, 0x5C}: '接',
+	{0x40, 0x5D}: '摂',
+	{0x40, 0x5E}: '折',
+	{0x40, 0x5F}: '設',
+	{0x40, 0x60}: '窃',
+	{0x40, 0x61}: '節',
+	{0x40, 0x62}: '説',
+	{0x40, 0x63}: '雪',
+	{0x40, 0x64}: '絶',
+	{0x40, 0x65}: '舌',
+	{0x40, 0x66}: '蝉',
+	{0x40, 0x67}: '仙',
+	{0x40, 0x68}: '先',
+	{0x40, 0x69}: '千',
+	{0x40, 0x6A}: '占',
+	{0x40, 0x6B}: '宣',
+	{0x40, 0x6C}: '専',
+	{0x40, 0x6D}: '尖',
+	{0x40, 0x6E}: '川',
+	{0x40, 0x6F}: '戦',
+	{0x40, 0x70}: '扇',
+	{0x40, 0x71}: '撰',
+	{0x40, 0x72}: '栓',
+	{0x40, 0x73}: '栴',
+	{0x40, 0x74}: '泉',
+	{0x40, 0x75}: '浅',
+	{0x40, 0x76}: '洗',
+	{0x40, 0x77}: '染',
+	{0x40, 0x78}: '潜',
+	{0x40, 0x79}: '煎',
+	{0x40, 0x7A}: '煽',
+	{0x40, 0x7B}: '旋',
+	{0x40, 0x7C}: '穿',
+	{0x40, 0x7D}: '箭',
+	{0x40, 0x7E}: '線',
+	{0x41, 0x21}: '繊',
+	{0x41, 0x22}: '羨',
+	{0x41, 0x23}: '腺',
+	{0x41, 0x24}: '舛',
+	{0x41, 0x25}: '船',
+	{0x41, 0x26}: '薦',
+	{0x41, 0x27}: '詮',
+	{0x41, 0x28}: '賎',
+	{0x41, 0x29}: '践',
+	{0x41, 0x2A}: '選',
+	{0x41, 0x2B}: '遷',
+	{0x41, 0x2C}: '銭',
+	{0x41, 0x2D}: '銑',
+	{0x41, 0x2E}: '閃',
+	{0x41, 0x2F}: '鮮',
+	{0x41, 0x30}: '前',
+	{0x41, 0x31}: '善',
+	{0x41, 0x32}: '漸',
+	{0x41, 0x33}: '然',
+	{0x41, 0x34}: '全',
+	{0x41, 0x35}: '禅',
+	{0x41, 0x36}: '繕',
+	{0x41, 0x37}: '膳',
+	{0x41, 0x38}: '糎',
+	{0x41, 0x39}: '噌',
+	{0x41, 0x3A}: '塑',
+	{0x41, 0x3B}: '岨',
+	{0x41, 0x3C}: '措',
+	{0x41, 0x3D}: '曾',
+	{0x41, 0x3E}: '曽',
+	{0x41, 0x3F}: '楚',
+	{0x41, 0x40}: '狙',
+	{0x41, 0x41}: '疏',
+	{0x41, 0x42}: '疎',
+	{0x41, 0x43}: '礎',
+	{0x41, 0x44}: '祖',
+	{0x41, 0x45}: '租',
+	{0x41, 0x46}: '粗',
+	{0x41, 0x47}: '素',
+	{0x41, 0x48}: '組',
+	{0x41, 0x49}: '蘇',
+	{0x41, 0x4A}: '訴',
+	{0x41, 0x4B}: '阻',
+	{0x41, 0x4C}: '遡',
+	{0x41, 0x4D}: '鼠',
+	{0x41, 0x4E}: '僧',
+	{0x41, 0x4F}: '創',
+	{0x41, 0x50}: '双',
+	{0x41, 0x51}: '叢',
+	{0x41, 0x52}: '倉',
+	{0x41, 0x53}: '喪',
+	{0x41, 0x54}: '壮',
+	{0x41, 0x55}: '奏',
+	{0x41, 0x56}: '爽',
+	{0x41, 0x57}: '宋',
+	{0x41, 0x58}: '層',
+	{0x41, 0x59}: '匝',
+	{0x41, 0x5A}: '惣',
+	{0x41, 0x5B}: '想',
+	{0x41, 0x5C}: '捜',
+	{0x41, 0x5D}: '掃',
+	{0x41, 0x5E}: '挿',
+	{0x41, 0x5F}: '掻',
+	{0x41, 0x60}: '操',
+	{0x41, 0x61}: '早',
+	{0x41, 0x62}: '曹',
+	{0x41, 0x63}: '巣',
+	{0x41, 0x64}: '槍',
+	{0x41, 0x65}: '槽',
+	{0x41, 0x66}: '漕',
+	{0x41, 0x67}: '燥',
+	{0x41, 0x68}: '争',
+	{0x41, 0x69}: '痩',
+	{0x41, 0x6A}: '相',
+	{0x41, 0x6B}: '窓',
+	{0x41, 0x6C}: '糟',
+	{0x41, 0x6D}: '総',
+	{0x41, 0x6E}: '綜',
+	{0x41, 0x6F}: '聡',
+	{0x41, 0x70}: '草',
+	{0x41, 0x71}: '荘',
+	{0x41, 0x72}: '葬',
+	{0x41, 0x73}: '蒼',
+	{0x41, 0x74}: '藻',
+	{0x41, 0x75}: '装',
+	{0x41, 0x76}: '走',
+	{0x41, 0x77}: '送',
+	{0x41, 0x78}: '遭',
+	{0x41, 0x79}: '鎗',
+	{0x41, 0x7A}: '霜',
+	{0x41, 0x7B}: '騒',
+	{0x41, 0x7C}: '像',
+	{0x41, 0x7D}: '増',
+	{0x41, 0x7E}: '憎',
+	{0x42, 0x21}: '臓',
+	{0x42, 0x22}: '蔵',
+	{0x42, 0x23}: '贈',
+	{0x42, 0x24}: '造',
+	{0x42, 0x25}: '促',
+	{0x42, 0x26}: '側',
+	{0x42, 0x27}: '則',
+	{0x42, 0x28}: '即',
+	{0x42, 0x29}: '息',
+	{0x42, 0x2A}: '捉',
+	{0x42, 0x2B}: '束',
+	{0x42, 0x2C}: '測',
+	{0x42, 0x2D}: '足',
+	{0x42, 0x2E}: '速',
+	{0x42, 0x2F}: '俗',
+	{0x42, 0x30}: '属',
+	{0x42, 0x31}: '賊',
+	{0x42, 0x32}: '族',
+	{0x42, 0x33}: '続',
+	{0x42, 0x34}: '卒',
+	{0x42, 0x35}: '袖',
+	{0x42, 0x36}: '其',
+	{0x42, 0x37}: '揃',
+	{0x42, 0x38}: '存',
+	{0x42, 0x39}: '孫',
+	{0x42, 0x3A}: '尊',
+	{0x42, 0x3B}: '損',
+	{0x42, 0x3C}: '村',
+	{0x42, 0x3D}: '遜',
+	{0x42, 0x3E}: '他',
+	{0x42, 0x3F}: '多',
+	{0x42, 0x40}: '太',
+	{0x42, 0x41}: '汰',
+	{0x42, 0x42}: '詑',
+	{0x42, 0x43}: '唾',
+	{0x42, 0x44}: '堕',
+	{0x42, 0x45}: '妥',
+	{0x42, 0x46}: '惰',
+	{0x42, 0x47}: '打',
+	{0x42, 0x48}: '柁',
+	{0x42, 0x49}: '舵',
+	{0x42, 0x4A}: '楕',
+	{0x42, 0x4B}: '陀',
+	{0x42, 0x4C}: '駄',
+	{0x42, 0x4D}: '騨',
+	{0x42, 0x4E}: '体',
+	{0x42, 0x4F}: '堆',
+	{0x42, 0x50}: '対',
+	{0x42, 0x51}: '耐',
+	{0x42, 0x52}: '岱',
+	{0x42, 0x53}: '帯',
+	{0x42, 0x54}: '待',
+	{0x42, 0x55}: '怠',
+	{0x42, 0x56}: '態',
+	{0x42, 0x57}: '戴',
+	{0x42, 0x58}: '替',
+	{0x42, 0x59}: '泰',
+	{0x42, 0x5A}: '滞',
+	{0x42, 0x5B}: '胎',
+	{0x42, 0x5C}: '腿',
+	{0x42, 0x5D}: '苔',
+	{0x42, 0x5E}: '袋',
+	{0x42, 0x5F}: '貸',
+	{0x42, 0x60}: '退',
+	{0x42, 0x61}: '逮',
+	{0x42, 0x62}: '隊',
+	{0x42, 0x63}: '黛',
+	{0x42, 0x64}: '鯛',
+	{0x42, 0x65}: '代',
+	{0x42, 0x66}: '台',
+	{0x42, 0x67}: '大',
+	{0x42, 0x68}: '第',
+	{0x42, 0x69}: '醍',
+	{0x42, 0x6A}: '題',
+	{0x42, 0x6B}: '鷹',
+	{0x42, 0x6C}: '滝',
+	{0x42, 0x6D}: '瀧',
+	{0x42, 0x6E}: '卓',
+	{0x42, 0x6F}: '啄',
+	{0x42, 0x70}: '宅',
+	{0x42, 0x71}: '托',
+	{0x42, 0x72}: '択',
+	{0x42, 0x73}: '拓',
+	{0x42, 0x74}: '沢',
+	{0x42, 0x75}: '濯',
+	{0x42, 0x76}: '琢',
+	{0x42, 0x77}: '託',
+	{0x42, 0x78}: '鐸',
+	{0x42, 0x79}: '濁',
+	{0x42, 0x7A}: '諾',
+	{0x42, 0x7B}: '茸',
+	{0x42, 0x7C}: '凧',
+	{0x42, 0x7D}: '蛸',
+	{0x42, 0x7E}: '只',
+	{0x43, 0x21}: '叩',
+	{0x43, 0x22}: '但',
+	{0x43, 0x23}: '達',
+	{0x43, 0x24}: '辰',
+	{0x43, 0x25}: '奪',
+	{0x43, 0x26}: '脱',
+	{0x43, 0x27}: '巽',
+	{0x43, 0x28}: '竪',
+	{0x43, 0x29}: '辿',
+	{0x43, 0x2A}: '棚',
+	{0x43, 0x2B}: '谷',
+	{0x43, 0x2C}: '狸',
+	{0x43, 0x2D}: '鱈',
+	{0x43, 0x2E}: '樽',
+	{0x43, 0x2F}: '誰',
+	{0x43, 0x30}: '丹',
+	{0x43, 0x31}: '単',
+	{0x43, 0x32}: '嘆',
+	{0x43, 0x33}: '坦',
+	{0x43, 0x34}: '担',
+	{0x43, 0x35}: '探',
+	{0x43, 0x36}: '旦',
+	{0x43, 0x37}: '歎',
+	{0x43, 0x38}: '淡',
+	{0x43, 0x39}: '湛',
+	{0x43, 0x3A}: '炭',
+	{0x43, 0x3B}: '短',
+	{0x43, 0x3C}: '端',
+	{0x43, 0x3D}: '箪',
+	{0x43, 0x3E}: '綻',
+	{0x43, 0x3F}: '耽',
+	{0x43, 0x40}: '胆',
+	{0x43, 0x41}: '蛋',
+	{0x43, 0x42}: '誕',
+	{0x43, 0x43}: '鍛',
+	{0x43, 0x44}: '団',
+	{0x43, 0x45}: '壇',
+	{0x43, 0x46}: '弾',
+	{0x43, 0x47}: '断',
+	{0x43, 0x48}: '暖',
+	{0x43, 0x49}: '檀',
+	{0x43, 0x4A}: '段',
+	{0x43, 0x4B}: '男',
+	{0x43, 0x4C}: '談',
+	{0x43, 0x4D}: '値',
+	{0x43, 0x4E}: '知',
+	{0x43, 0x4F}: '地',
+	{0x43, 0x50}: '弛',
+	{0x43, 0x51}: '恥',
+	{0x43, 0x52}: '智',
+	{0x43, 0x53}: '池',
+	{0x43, 0x54}: '痴',
+	{0x43, 0x55}: '稚',
+	{0x43, 0x56}: '置',
+	{0x43, 0x57}: '致',
+	{0x43, 0x58}: '蜘',
+	{0x43, 0x59}: '遅',
+	{0x43, 0x5A}: '馳',
+	{0x43, 0x5B}: '築',
+	{0x43, 0x5C}: '畜',
+	{0x43, 0x5D}: '竹',
+	{0x43, 0x5E}: '筑',
+	{0x43, 0x5F}: '蓄',
+	{0x43, 0x60}: '逐',
+	{0x43, 0x61}: '秩',
+	{0x43, 0x62}: '窒',
+	{0x43, 0x63}: '茶',
+	{0x43, 0x64}: '嫡',
+	{0x43, 0x65}: '着',
+	{0x43, 0x66}: '中',
+	{0x43, 0x67}: '仲',
+	{0x43, 0x68}: '宙',
+	{0x43, 0x69}: '忠',
+	{0x43, 0x6A}: '抽',
+	{0x43, 0x6B}: '昼',
+	{0x43, 0x6C}: '柱',
+	{0x43, 0x6D}: '注',
+	{0x43, 0x6E}: '虫',
+	{0x43, 0x6F}: '衷',
+	{0x43, 0x70}: '註',
+	{0x43, 0x71}: '酎',
+	{0x43, 0x72}: '鋳',
+	{0x43, 0x73}: '駐',
+	{0x43, 0x74}: '樗',
+	{0x43, 0x75}: '瀦',
+	{0x43, 0x76}: '猪',
+	{0x43, 0x77}: '苧',
+	{0x43, 0x78}: '著',
+	{0x43, 0x79}: '貯',
+	{0x43, 0x7A}: '丁',
+	{0x43, 0x7B}: '兆',
+	{0x43, 0x7C}: '凋',
+	{0x43, 0x7D}: '喋',
+	{0x43, 0x7E}: '寵',
+	{0x44, 0x21}: '帖',
+	{0x44, 0x22}: '帳',
+	{0x44, 0x23}: '庁',
+	{0x44, 0x24}: '弔',
+	{0x44, 0x25}: '張',
+	{0x44, 0x26}: '彫',
+	{0x44, 0x27}: '徴',
+	{0x44, 0x28}: '懲',
+	{0x44, 0x29}: '挑',
+	{0x44, 0x2A}: '暢',
+	{0x44, 0x2B}: '朝',
+	{0x44, 0x2C}: '潮',
+	{0x44, 0x2D}: '牒',
+	{0x44, 0x2E}: '町',
+	{0x44, 0x2F}: '眺',
+	{0x44, 0x30}: '聴',
+	{0x44, 0x31}: '脹',
+	{0x44, 0x32}: '腸',
+	{0x44, 0x33}: '蝶',
+	{0x44, 0x34}: '調',
+	{0x44, 0x35}: '諜',
+	{0x44, 0x36}: '超',
+	{0x44, 0x37}: '跳',
+	{0x44, 0x38}: '銚',
+	{0x44, 0x39}: '長',
+	{0x44, 0x3A}: '頂',
+	{0x44, 0x3B}: '鳥',
+	{0x44, 0x3C}: '勅',
+	{0x44, 0x3D}: '捗',
+	{0x44, 0x3E}: '直',
+	{0x44, 0x3F}: '朕',
+	{0x44, 0x40}: '沈',
+	{0x44, 0x41}: '珍',
+	{0x44, 0x42}: '賃',
+	{0x44, 0x43}: '鎮',
+	{0x44, 0x44}: '陳',
+	{0x44, 0x45}: '津',
+	{0x44, 0x46}: '墜',
+	{0x44, 0x47}: '椎',
+	{0x44, 0x48}: '槌',
+	{0x44, 0x49}: '追',
+	{0x44, 0x4A}: '鎚',
+	{0x44, 0x4B}: '痛',
+	{0x44, 0x4C}: '通',
+	{0x44, 0x4D}: '塚',
+	{0x44, 0x4E}: '栂',
+	{0x44, 0x4F}: '掴',
+	{0x44, 0x50}: '槻',
+	{0x44, 0x51}: '佃',
+	{0x44, 0x52}: '漬',
+	{0x44, 0x53}: '柘',
+	{0x44, 0x54}: '辻',
+	{0x44, 0x55}: '蔦',
+	{0x44, 0x56}: '綴',
+	{0x44, 0x57}: '鍔',
+	{0x44, 0x58}: '椿',
+	{0x44, 0x59}: '潰',
+	{0x44, 0x5A}: '坪',
+	{0x44, 0x5B}: '壷',
+	{0x44, 0x5C}: '嬬',
+	{0x44, 0x5D}: '紬',
+	{0x44, 0x5E}: '爪',
+	{0x44, 0x5F}: '吊',
+	{0x44, 0x60}: '釣',
+	{0x44, 0x61}: '鶴',
+	{0x44, 0x62}: '亭',
+	{0x44, 0x63}: '低',
+	{0x44, 0x64}: '停',
+	{0x44, 0x65}: '偵',
+	{0x44, 0x66}: '剃',
+	{0x44, 0x67}: '貞',
+	{0x44, 0x68}: '呈',
+	{0x44, 0x69}: '堤',
+	{0x44, 0x6A}: '定',
+	{0x44, 0x6B}: '帝',
+	{0x44, 0x6C}: '底',
+	{0x44, 0x6D}: '庭',
+	{0x44, 0x6E}: '廷',
+	{0x44, 0x6F}: '弟',
+	{0x44, 0x70}: '悌',
+	{0x44, 0x71}: '抵',
+	{0x44, 0x72}: '挺',
+	{0x44, 0x73}: '提',
+	{0x44, 0x74}: '梯',
+	{0x44, 0x75}: '汀',
+	{0x44, 0x76}: '碇',
+	{0x44, 0x77}: '禎',
+	{0x44, 0x78}: '程',
+	{0x44, 0x79}: '締',
+	{0x44, 0x7A}: '艇',
+	{0x44, 0x7B}: '訂',
+	{0x44, 0x7C}: '諦',
+	{0x44, 0x7D}: '蹄',
+	{0x44, 0x7E}: '逓',
+	{0x45, 0x21}: '邸',
+	{0x45, 0x22}: '鄭',
+	{0x45, 0x23}: '釘',
+	{0x45, 0x24}: '鼎',
+	{0x45, 0x25}: '泥',
+	{0x45, 0x26}: '摘',
+	{0x45, 0x27}: '擢',
+	{0x45, 0x28}: '敵',
+	{0x45, 0x29}: '滴',
+	{0x45, 0x2A}: '的',
+	{0x45, 0x2B}: '笛',
+	{0x45, 0x2C}: '適',
+	{0x45, 0x2D}: '鏑',
+	{0x45, 0x2E}: '溺',
+	{0x45, 0x2F}: '哲',
+	{0x45, 0x30}: '徹',
+	{0x45, 0x31}: '撤',
+	{0x45, 0x32}: '轍',
+	{0x45, 0x33}: '迭',
+	{0x45, 0x34}: '鉄',
+	{0x45, 0x35}: '典',
+	{0x45, 0x36}: '填',
+	{0x45, 0x37}: '天',
+	{0x45, 0x38}: '展',
+	{0x45, 0x39}: '店',
+	{0x45, 0x3A}: '添',
+	{0x45, 0x3B}: '纏',
+	{0x45, 0x3C}: '甜',
+	{0x45, 0x3D}: '貼',
+	{0x45, 0x3E}: '転',
+	{0x45, 0x3F}: '顛',
+	{0x45, 0x40}: '点',
+	{0x45, 0x41}: '伝',
+	{0x45, 0x42}: '殿',
+	{0x45, 0x43}: '澱',
+	{0x45, 0x44}: '田',
+	{0x45, 0x45}: '電',
+	{0x45, 0x46}: '兎',
+	{0x45, 0x47}: '吐',
+	{0x45, 0x48}: '堵',
+	{0x45, 0x49}: '塗',
+	{0x45, 0x4A}: '妬',
+	{0x45, 0x4B}: '屠',
+	{0x45, 0x4C}: '徒',
+	{0x45, 0x4D}: '斗',
+	{0x45, 0x4E}: '杜',
+	{0x45, 0x4F}: '渡',
+	{0x45, 0x50}: '登',
+	{0x45, 0x51}: '菟',
+	{0x45, 0x52}: '賭',
+	{0x45, 0x53}: '途',
+	{0x45, 0x54}: '都',
+	{0x45, 0x55}: '鍍',
+	{0x45, 0x56}: '砥',
+	{0x45, 0x57}: '砺',
+	{0x45, 0x58}: '努',
+	{0x45, 0x59}: '度',
+	{0x45, 0x5A}: '土',
+	{0x45, 0x5B}: '奴',
+	{0x45, 0x5C}: '怒',
+	{0x45, 0x5D}: '倒',
+	{0x45, 0x5E}: '党',
+	{0x45, 0x5F}: '冬',
+	{0x45, 0x60}: '凍',
+	{0x45, 0x61}: '刀',
+	{0x45, 0x62}: '唐',
+	{0x45, 0x63}: '塔',
+	{0x45, 0x64}: '塘',
+	{0x45, 0x65}: '套',
+	{0x45, 0x66}: '宕',
+	{0x45, 0x67}: '島',
+	{0x45, 0x68}: '嶋',
+	{0x45, 0x69}: '悼',
+	{0x45, 0x6A}: '投',
+	{0x45, 0x6B}: '搭',
+	{0x45, 0x6C}: '東',
+	{0x45, 0x6D}: '桃',
+	{0x45, 0x6E}: '梼',
+	{0x45, 0x6F}: '棟',
+	{0x45, 0x70}: '盗',
+	{0x45, 0x71}: '淘',
+	{0x45, 0x72}: '湯',
+	{0x45, 0x73}: '涛',
+	{0x45, 0x74}: '灯',
+	{0x45, 0x75}: '燈',
+	{0x45, 0x76}: '当',
+	{0x45, 0x77}: '痘',
+	{0x45, 0x78}: '祷',
+	{0x45, 0x79}: '等',
+	{0x45, 0x7A}: '答',
+	{0x45, 0x7B}: '筒',
+	{0x45, 0x7C}: '糖',
+	{0x45, 0x7D}: '統',
+	{0x45, 0x7E}: '到',
+	{0x46, 0x21}: '董',
+	{0x46, 0x22}: '蕩',
+	{0x46, 0x23}: '藤',
+	{0x46, 0x24}: '討',
+	{0x46, 0x25}: '謄',
+	{0x46, 0x26}: '豆',
+	{0x46, 0x27}: '踏',
+	{0x46, 0x28}: '逃',
+	{0x46, 0x29}: '透',
+	{0x46, 0x2A}: '鐙',
+	{0x46, 0x2B}: '陶',
+	{0x46, 0x2C}: '頭',
+	{0x46, 0x2D}: '騰',
+	{0x46, 0x2E}: '闘',
+	{0x46, 0x2F}: '働',
+	{0x46, 0x30}: '動',
+	{0x46, 0x31}: '同',
+	{0x46, 0x32}: '堂',
+	{0x46, 0x33}: '導',
+	{0x46, 0x34}: '憧',
+	{0x46, 0x35}: '撞',
+	{0x46, 0x36}: '洞',
+	{0x46, 0x37}: '瞳',
+	{0x46, 0x38}: '童',
+	{0x46, 0x39}: '胴',
+	{0x46, 0x3A}: '萄',
+	{0x46, 0x3B}: '道',
+	{0x46, 0x3C}: '銅',
+	{0x46, 0x3D}: '峠',
+	{0x46, 0x3E}: '鴇',
+	{0x46, 0x3F}: '匿',
+	{0x46, 0x40}: '得',
+	{0x46, 0x41}: '徳',
+	{0x46, 0x42}: '涜',
+	{0x46, 0x43}: '特',
+	{0x46, 0x44}: '督',
+	{0x46, 0x45}: '禿',
+	{0x46, 0x46}: '篤',
+	{0x46, 0x47}: '毒',
+	{0x46, 0x48}: '独',
+	{0x46, 0x49}: '読',
+	{0x46, 0x4A}: '栃',
+	{0x46, 0x4B}: '橡',
+	{0x46, 0x4C}: '凸',
+	{0x46, 0x4D}: '突',
+	{0x46, 0x4E}: '椴',
+	{0x46, 0x4F}: '届',
+	{0x46, 0x50}: '鳶',
+	{0x46, 0x51}: '苫',
+	{0x46, 0x52}: '寅',
+	{0x46, 0x53}: '酉',
+	{0x46, 0x54}: '瀞',
+	{0x46, 0x55}: '噸',
+	{0x46, 0x56}: '屯',
+	{0x46, 0x57}: '惇',
+	{0x46, 0x58}: '敦',
+	{0x46, 0x59}: '沌',
+	{0x46, 0x5A}: '豚',
+	{0x46, 0x5B}: '遁',
+	{0x46, 0x5C}: '頓',
+	{0x46, 0x5D}: '呑',
+	{0x46, 0x5E}: '曇',
+	{0x46, 0x5F}: '鈍',
+	{0x46, 0x60}: '奈',
+	{0x46, 0x61}: '那',
+	{0x46, 0x62}: '内',
+	{0x46, 0x63}: '乍',
+	{0x46, 0x64}: '凪',
+	{0x46, 0x65}: '薙',
+	{0x46, 0x66}: '謎',
+	{0x46, 0x67}: '灘',
+	{0x46, 0x68}: '捺',
+	{0x46, 0x69}: '鍋',
+	{0x46, 0x6A}: '楢',
+	{0x46, 0x6B}: '馴',
+	{0x46, 0x6C}: '縄',
+	{0x46, 0x6D}: '畷',
+	{0x46, 0x6E}: '南',
+	{0x46, 0x6F}: '楠',
+	{0x46, 0x70}: '軟',
+	{0x46, 0x71}: '難',
+	{0x46, 0x72}: '汝',
+	{0x46, 0x73}: '二',
+	{0x46, 0x74}: '尼',
+	{0x46, 0x75}: '弐',
+	{0x46, 0x76}: '迩',
+	{0x46, 0x77}: '匂',
+	{0x46, 0x78}: '賑',
+	{0x46, 0x79}: '肉',
+	{0x46, 0x7A}: '虹',
+	{0x46, 0x7B}: '廿',
+	{0x46, 0x7C}: '日',
+	{0x46, 0x7D}: '乳',
+	{0x46, 0x7E}: '入',
+	{0x47, 0x21}: '如',
+	{0x47, 0x22}: '尿',
+	{0x47, 0x23}: '韮',
+	{0x47, 0x24}: '任',
+	{0x47, 0x25}: '妊',
+	{0x47, 0x26}: '忍',
+	{0x47, 0x27}: '認',
+	{0x47, 0x28}: '濡',
+	{0x47, 0x29}: '禰',
+	{0x47, 0x2A}: '祢',
+	{0x47, 0x2B}: '寧',
+	{0x47, 0x2C}: '葱',
+	{0x47, 0x2D}: '猫',
+	{0x47, 0x2E}: '熱',
+	{0x47, 0x2F}: '年',
+	{0x47, 0x30}: '念',
+	{0x47, 0x31}: '捻',
+	{0x47, 0x32}: '撚',
+	{0x47, 0x33}: '燃',
+	{0x47, 0x34}: '粘',
+	{0x47, 0x35}: '乃',
+	{0x47, 0x36}: '廼',
+	{0x47, 0x37}: '之',
+	{0x47, 0x38}: '埜',
+	{0x47, 0x39}: '嚢',
+	{0x47, 0x3A}: '悩',
+	{0x47, 0x3B}: '濃',
+	{0x47, 0x3C}: '納',
+	{0x47, 0x3D}: '能',
+	{0x47, 0x3E}: '脳',
+	{0x47, 0x3F}: '膿',
+	{0x47, 0x40}: '農',
+	{0x47, 0x41}: '覗',
+	{0x47, 0x42}: '蚤',
+	{0x47, 0x43}: '巴',
+	{0x47, 0x44}: '把',
+	{0x47, 0x45}: '播',
+	{0x47, 0x46}: '覇',
+	{0x47, 0x47}: '杷',
+	{0x47, 0x48}: '波',
+	{0x47, 0x49}: '派',
+	{0x47, 0x4A}: '琶',
+	{0x47, 0x4B}: '破',
+	{0x47, 0x4C}: '婆',
+	{0x47, 0x4D}: '罵',
+	{0x47, 0x4E}: '芭',
+	{0x47, 0x4F}: '馬',
+	{0x47, 0x50}: '俳',
+	{0x47, 0x51}: '廃',
+	{0x47, 0x52}: '拝',
+	{0x47, 0x53}: '排',
+	{0x47, 0x54}: '敗',
+	{0x47, 0x55}: '杯',
+	{0x47, 0x56}: '盃',
+	{0x47, 0x57}: '牌',
+	{0x47, 0x58}: '背',
+	{0x47, 0x59}: '肺',
+	{0x47, 0x5A}: '輩',
+	{0x47, 0x5B}: '配',
+	{0x47, 0x5C}: '倍',
+	{0x47, 0x5D}: '培',
+	{0x47, 0x5E}: '媒',
+	{0x47, 0x5F}: '梅',
+	{0x47, 0x60}: '楳',
+	{0x47, 0x61}: '煤',
+	{0x47, 0x62}: '狽',
+	{0x47, 0x63}: '買',
+	{0x47, 0x64}: '売',
+	{0x47, 0x65}: '賠',
+	{0x47, 0x66}: '陪',
+	{0x47, 0x67}: '這',
+	{0x47, 0x68}: '蝿',
+	{0x47, 0x69}: '秤',
+	{0x47, 0x6A}: '矧',
+	{0x47, 0x6B}: '萩',
+	{0x47, 0x6C}: '伯',
+	{0x47, 0x6D}: '剥',
+	{0x47, 0x6E}: '博',
+	{0x47, 0x6F}: '拍',
+	{0x47, 0x70}: '柏',
+	{0x47, 0x71}: '泊',
+	{0x47, 0x72}: '白',
+	{0x47, 0x73}: '箔',
+	{0x47, 0x74}: '粕',
+	{0x47, 0x75}: '舶',
+	{0x47, 0x76}: '薄',
+	{0x47, 0x77}: '迫',
+	{0x47, 0x78}: '曝',
+	{0x47, 0x79}: '漠',
+	{0x47, 0x7A}: '爆',
+	{0x47, 0x7B}: '縛',
+	{0x47, 0x7C}: '莫',
+	{0x47, 0x7D}: '駁',
+	{0x47, 0x7E}: '麦',
+	{0x48, 0x21}: '函',
+	{0x48, 0x22}: '箱',
+	{0x48, 0x23}: '硲',
+	{0x48, 0x24}: '箸',
+	{0x48, 0x25}: '肇',
+	{0x48, 0x26}: '筈',
+	{0x48, 0x27}: '櫨',
+	{0x48, 0x28}: '幡',
+	{0x48, 0x29}: '肌',
+	{0x48, 0x2A}: '畑',
+	{0x48, 0x2B}: '畠',
+	{0x48, 0x2C}: '八',
+	{0x48, 0x2D}: '鉢',
+	{0x48, 0x2E}: '溌',
+	{0x48, 0x2F}: '発',
+	{0x48, 0x30}: '醗',
+	{0x48, 0x31}: '髪',
+	{0x48, 0x32}: '伐',
+	{0x48, 0x33}: '罰',
+	{0x48, 0x34}: '抜',
+	{0x48, 0x35}: '筏',
+	{0x48, 0x36}: '閥',
+	{0x48, 0x37}: '鳩',
+	{0x48, 0x38}: '噺',
+	{0x48, 0x39}: '塙',
+	{0x48, 0x3A}: '蛤',
+	{0x48, 0x3B}: '隼',
+	{0x48, 0x3C}: '伴',
+	{0x48, 0x3D}: '判',
+	{0x48, 0x3E}: '半',
+	{0x48, 0x3F}: '反',
+	{0x48, 0x40}: '叛',
+	{0x48, 0x41}: '帆',
+	{0x48, 0x42}: '搬',
+	{0x48, 0x43}: '斑',
+	{0x48, 0x44}: '板',
+	{0x48, 0x45}: '氾',
+	{0x48, 0x46}: '汎',
+	{0x48, 0x47}: '版',
+	{0x48, 0x48}: '犯',
+	{0x48, 0x49}: '班',
+	{0x48, 0x4A}: '畔',
+	{0x48, 0x4B}: '繁',
+	{0x48, 0x4C}: '般',
+	{0x48, 0x4D}: '藩',
+	{0x48, 0x4E}: '販',
+	{0x48, 0x4F}: '範',
+	{0x48, 0x50}: '釆',
+	{0x48, 0x51}: '煩',
+	{0x48, 0x52}: '頒',
+	{0x48, 0x53}: '飯',
+	{0x48, 0x54}: '挽',
+	{0x48, 0x55}: '晩',
+	{0x48, 0x56}: '番',
+	{0x48, 0x57}: '盤',
+	{0x48, 0x58}: '磐',
+	{0x48, 0x59}: '蕃',
+	{0x48, 0x5A}: '蛮',
+	{0x48, 0x5B}: '匪',
+	{0x48, 0x5C}: '卑',
+	{0x48, 0x5D}: '否',
+	{0x48, 0x5E}: '妃',
+	{0x48, 0x5F}: '庇',
+	{0x48, 0x60}: '彼',
+	{0x48, 0x61}: '悲',
+	{0x48, 0x62}: '扉',
+	{0x48, 0x63}: '批',
+	{0x48, 0x64}: '披',
+	{0x48, 0x65}: '斐',
+	{0x48, 0x66}: '比',
+	{0x48, 0x67}: '泌',
+	{0x48, 0x68}: '疲',
+	{0x48, 0x69}: '皮',
+	{0x48, 0x6A}: '碑',
+	{0x48, 0x6B}: '秘',
+	{0x48, 0x6C}: '緋',
+	{0x48, 0x6D}: '罷',
+	{0x48, 0x6E}: '肥',
+	{0x48, 0x6F}: '被',
+	{0x48, 0x70}: '誹',
+	{0x48, 0x71}: '費',
+	{0x48, 0x72}: '避',
+	{0x48, 0x73}: '非',
+	{0x48, 0x74}: '飛',
+	{0x48, 0x75}: '樋',
+	{0x48, 0x76}: '簸',
+	{0x48, 0x77}: '備',
+	{0x48, 0x78}: '尾',
+	{0x48, 0x79}: '微',
+	{0x48, 0x7A}: '枇',
+	{0x48, 0x7B}: '毘',
+	{0x48, 0x7C}: '琵',
+	{0x48, 0x7D}: '眉',
+	{0x48, 0x7E}: '美',
+	{0x49, 0x21}: '鼻',
+	{0x49, 0x22}: '柊',
+	{0x49, 0x23}: '稗',
+	{0x49, 0x24}: '匹',
+	{0x49, 0x25}: '疋',
+	{0x49, 0x26}: '髭',
+	{0x49, 0x27}: '彦',
+	{0x49, 0x28}: '膝',
+	{0x49, 0x29}: '菱',
+	{0x49, 0x2A}: '肘',
+	{0x49, 0x2B}: '弼',
+	{0x49, 0x2C}: '必',
+	{0x49, 0x2D}: '畢',
+	{0x49, 0x2E}: '筆',
+	{0x49, 0x2F}: '逼',
+	{0x49, 0x30}: '桧',
+	{0x49, 0x31}: '姫',
+	{0x49, 0x32}: '媛',
+	{0x49, 0x33}: '紐',
+	{0x49, 0x34}: '百',
+	{0x49, 0x35}: '謬',
+	{0x49, 0x36}: '俵',
+	{0x49, 0x37}: '彪',
+	{0x49, 0x38}: '標',
+	{0x49, 0x39}: '氷',
+	{0x49, 0x3A}: '漂',
+	{0x49, 0x3B}: '瓢',
+	{0x49, 0x3C}: '票',
+	{0x49, 0x3D}: '表',
+	{0x49, 0x3E}: '評',
+	{0x49, 0x3F}: '豹',
+	{0x49, 0x40}: '廟',
+	{0x49, 0x41}: '描',
+	{0x49, 0x42}: '病',
+	{0x49, 0x43}: '秒',
+	{0x49, 0x44}: '苗',
+	{0x49, 0x45}: '錨',
+	{0x49, 0x46}: '鋲',
+	{0x49, 0x47}: '蒜',
+	{0x49, 0x48}: '蛭',
+	{0x49, 0x49}: '鰭',
+	{0x49, 0x4A}: '品',
+	{0x49, 0x4B}: '彬',
+	{0x49, 0x4C}: '斌',
+	{0x49, 0x4D}: '浜',
+	{0x49, 0x4E}: '瀕',
+	{0x49, 0x4F}: '貧',
+	{0x49, 0x50}: '賓',
+	{0x49, 0x51}: '頻',
+	{0x49, 0x52}: '敏',
+	{0x49, 0x53}: '瓶',
+	{0x49, 0x54}: '不',
+	{0x49, 0x55}: '付',
+	{0x49, 0x56}: '埠',
+	{0x49, 0x57}: '夫',
+	{0x49, 0x58}: '婦',
+	{0x49, 0x59}: '富',
+	{0x49, 0x5A}: '冨',
+	{0x49, 0x5B}: '布',
+	{0x49, 0x5C}: '府',
+	{0x49, 0x5D}: '怖',
+	{0x49, 0x5E}: '扶',
+	{0x49, 0x5F}: '敷',
+	{0x49, 0x60}: '斧',
+	{0x49, 0x61}: '普',
+	{0x49, 0x62}: '浮',
+	{0x49, 0x63}: '父',
+	{0x49, 0x64}: '符',
+	{0x49, 0x65}: '腐',
+	{0x49, 0x66}: '膚',
+	{0x49, 0x67}: '芙',
+	{0x49, 0x68}: '譜',
+	{0x49, 0x69}: '負',
+	{0x49, 0x6A}: '賦',
+	{0x49, 0x6B}: '赴',
+	{0x49, 0x6C}: '阜',
+	{0x49, 0x6D}: '附',
+	{0x49, 0x6E}: '侮',
+	{0x49, 0x6F}: '撫',
+	{0x49, 0x70}: '武',
+	{0x49, 0x71}: '舞',
+	{0x49, 0x72}: '葡',
+	{0x49, 0x73}: '蕪',
+	{0x49, 0x74}: '部',
+	{0x49, 0x75}: '封',
+	{0x49, 0x76}: '楓',
+	{0x49, 0x77}: '風',
+	{0x49, 0x78}: '葺',
+	{0x49, 0x79}: '蕗',
+	{0x49, 0x7A}: '伏',
+	{0x49, 0x7B}: '副',
+	{0x49, 0x7C}: '復',
+	{0x49, 0x7D}: '幅',
+	{0x49, 0x7E}: '服',
+	{0x4A, 0x21}: '福',
+	{0x4A, 0x22}: '腹',
+	{0x4A, 0x23}: '複',
+	{0x4A, 0x24}: '覆',
+	{0x4A, 0x25}: '淵',
+	{0x4A, 0x26}: '弗',
+	{0x4A, 0x27}: '払',
+	{0x4A, 0x28}: '沸',
+	{0x4A, 0x29}: '仏',
+	{0x4A, 0x2A}: '物',
+	{0x4A, 0x2B}: '鮒',
+	{0x4A, 0x2C}: '分',
+	{0x4A, 0x2D}: '吻',
+	{0x4A, 0x2E}: '噴',
+	{0x4A, 0x2F}: '墳',
+	{0x4A, 0x30}: '憤',
+	{0x4A, 0x31}: '扮',
+	{0x4A, 0x32}: '焚',
+	{0x4A, 0x33}: '奮',
+	{0x4A, 0x34}: '粉',
+	{0x4A, 0x35}: '糞',
+	{0x4A, 0x36}: '紛',
+	{0x4A, 0x37}: '雰',
+	{0x4A, 0x38}: '文',
+	{0x4A, 0x39}: '聞',
+	{0x4A, 0x3A}: '丙',
+	{0x4A, 0x3B}: '併',
+	{0x4A, 0x3C}: '兵',
+	{0x4A, 0x3D}: '塀',
+	{0x4A, 0x3E}: '幣',
+	{0x4A, 0x3F}: '平',
+	{0x4A, 0x40}: '弊',
+	{0x4A, 0x41}: '柄',
+	{0x4A, 0x42}: '並',
+	{0x4A, 0x43}: '蔽',
+	{0x4A, 0x44}: '閉',
+	{0x4A, 0x45}: '陛',
+	{0x4A, 0x46}: '米',
+	{0x4A, 0x47}: '頁',
+	{0x4A, 0x48}: '僻',
+	{0x4A, 0x49}: '壁',
+	{0x4A, 0x4A}: '癖',
+	{0x4A, 0x4B}: '碧',
+	{0x4A, 0x4C}: '別',
+	{0x4A, 0x4D}: '瞥',
+	{0x4A, 0x4E}: '蔑',
+	{0x4A, 0x4F}: '箆',
+	{0x4A, 0x50}: '偏',
+	{0x4A, 0x51}: '変',
+	{0x4A, 0x52}: '片',
+	{0x4A, 0x53}: '篇',
+	{0x4A, 0x54}: '編',
+	{0x4A, 0x55}: '辺',
+	{0x4A, 0x56}: '返',
+	{0x4A, 0x57}: '遍',
+	{0x4A, 0x58}: '便',
+	{0x4A, 0x59}: '勉',
+	{0x4A, 0x5A}: '娩',
+	{0x4A, 0x5B}: '弁',
+	{0x4A, 0x5C}: '鞭',
+	{0x4A, 0x5D}: '保',
+	{0x4A, 0x5E}: '舗',
+	{0x4A, 0x5F}: '鋪',
+	{0x4A, 0x60}: '圃',
+	{0x4A, 0x61}: '捕',
+	{0x4A, 0x62}: '歩',
+	{0x4A, 0x63}: '甫',
+	{0x4A, 0x64}: '補',
+	{0x4A, 0x65}: '輔',
+	{0x4A, 0x66}: '穂',
+	{0x4A, 0x67}: '募',
+	{0x4A, 0x68}: '墓',
+	{0x4A, 0x69}: '慕',
+	{0x4A, 0x6A}: '戊',
+	{0x4A, 0x6B}: '暮',
+	{0x4A, 0x6C}: '母',
+	{0x4A, 0x6D}: '簿',
+	{0x4A, 0x6E}: '菩',
+	{0x4A, 0x6F}: '倣',
+	{0x4A, 0x70}: '俸',
+	{0x4A, 0x71}: '包',
+	{0x4A, 0x72}: '呆',
+	{0x4A, 0x73}: '報',
+	{0x4A, 0x74}: '奉',
+	{0x4A, 0x75}: '宝',
+	{0x4A, 0x76}: '峰',
+	{0x4A, 0x77}: '峯',
+	{0x4A, 0x78}: '崩',
+	{0x4A, 0x79}: '庖',
+	{0x4A, 0x7A}: '抱',
+	{0x4A, 0x7B}: '捧',
+	{0x4A, 0x7C}: '放',
+	{0x4A, 0x7D}: '方',
+	{0x4A, 0x7E}: '朋',
+	{0x4B, 0x21}: '法',
+	{0x4B, 0x22}: '泡',
+	{0x4B, 0x23}: '烹',
+	{0x4B, 0x24}: '砲',
+	{0x4B, 0x25}: '縫',
+	{0x4B, 0x26}: '胞',
+	{0x4B, 0x27}: '芳',
+	{0x4B, 0x28}: '萌',
+	{0x4B, 0x29}: '蓬',
+	{0x4B, 0x2A}: '蜂',
+	{0x4B, 0x2B}: '褒',
+	{0x4B, 0x2C}: '訪',
+	{0x4B, 0x2D}: '豊',
+	{0x4B, 0x2E}: '邦',
+	{0x4B, 0x2F}: '鋒',
+	{0x4B, 0x30}: '飽',
+	{0x4B, 0x31}: '鳳',
+	{0x4B, 0x32}: '鵬',
+	{0x4B, 0x33}: '乏',
+	{0x4B, 0x34}: '亡',
+	{0x4B, 0x35}: '傍',
+	{0x4B, 0x36}: '剖',
+	{0x4B, 0x37}: '坊',
+	{0x4B, 0x38}: '妨',
+	{0x4B, 0x39}: '帽',
+	{0x4B, 0x3A}: '忘',
+	{0x4B, 0x3B}: '忙',
+	{0x4B, 0x3C}: '房',
+	{0x4B, 0x3D}: '暴',
+	{0x4B, 0x3E}: '望',
+	{0x4B, 0x3F}: '某',
+	{0x4B, 0x40}: '棒',
+	{0x4B, 0x41}: '冒',
+	{0x4B, 0x42}: '紡',
+	{0x4B, 0x43}: '肪',
+	{0x4B, 0x44}: '膨',
+	{0x4B, 0x45}: '謀',
+	{0x4B, 0x46}: '貌',
+	{0x4B, 0x47}: '貿',
+	{0x4B, 0x48}: '鉾',
+	{0x4B, 0x49}: '防',
+	{0x4B, 0x4A}: '吠',
+	{0x4B, 0x4B}: '頬',
+	{0x4B, 0x4C}: '北',
+	{0x4B, 0x4D}: '僕',
+	{0x4B, 0x4E}: '卜',
+	{0x4B, 0x4F}: '墨',
+	{0x4B, 0x50}: '撲',
+	{0x4B, 0x51}: '朴',
+	{0x4B, 0x52}: '牧',
+	{0x4B, 0x53}: '睦',
+	{0x4B, 0x54}: '穆',
+	{0x4B, 0x55}: '釦',
+	{0x4B, 0x56}: '勃',
+	{0x4B, 0x57}: '没',
+	{0x4B, 0x58}: '殆',
+	{0x4B, 0x59}: '堀',
+	{0x4B, 0x5A}: '幌',
+	{0x4B, 0x5B}: '奔',
+	{0x4B, 0x5C}: '本',
+	{0x4B, 0x5D}: '翻',
+	{0x4B, 0x5E}: '凡',
+	{0x4B, 0x5F}: '盆',
+	{0x4B, 0x60}: '摩',
+	{0x4B, 0x61}: '磨',
+	{0x4B, 0x62}: '魔',
+	{0x4B, 0x63}: '麻',
+	{0x4B, 0x64}: '埋',
+	{0x4B, 0x65}: '妹',
+	{0x4B, 0x66}: '昧',
+	{0x4B, 0x67}: '枚',
+	{0x4B, 0x68}: '毎',
+	{0x4B, 0x69}: '哩',
+	{0x4B, 0x6A}: '槙',
+	{0x4B, 0x6B}: '幕',
+	{0x4B, 0x6C}: '膜',
+	{0x4B, 0x6D}: '枕',
+	{0x4B, 0x6E}: '鮪',
+	{0x4B, 0x6F}: '柾',
+	{0x4B, 0x70}: '鱒',
+	{0x4B, 0x71}: '桝',
+	{0x4B, 0x72}: '亦',
+	{0x4B, 0x73}: '俣',
+	{0x4B, 0x74}: '又',
+	{0x4B, 0x75}: '抹',
+	{0x4B, 0x76}: '末',
+	{0x4B, 0x77}: '沫',
+	{0x4B, 0x78}: '迄',
+	{0x4B, 0x79}: '侭',
+	{0x4B, 0x7A}: '繭',
+	{0x4B, 0x7B}: '麿',
+	{0x4B, 0x7C}: '万',
+	{0x4B, 0x7D}: '慢',
+	{0x4B, 0x7E}: '満',
+	{0x4C, 0x21}: '漫',
+	{0x4C, 0x22}: '蔓',
+	{0x4C, 0x23}: '味',
+	{0x4C, 0x24}: '未',
+	{0x4C, 0x25}: '魅',
+	{0x4C, 0x26}: '巳',
+	{0x4C, 0x27}: '箕',
+	{0x4C, 0x28}: '岬',
+	{0x4C, 0x29}: '密',
+	{0x4C, 0x2A}: '蜜',
+	{0x4C, 0x2B}: '湊',
+	{0x4C, 0x2C}: '蓑',
+	{0x4C, 0x2D}: '稔',
+	{0x4C, 0x2E}: '脈',
+	{0x4C, 0x2F}: '妙',
+	{0x4C, 0x30}: '粍',
+	{0x4C, 0x31}: '民',
+	{0x4C, 0x32}: '眠',
+	{0x4C, 0x33}: '務',
+	{0x4C, 0x34}: '夢',
+	{0x4C, 0x35}: '無',
+	{0x4C, 0x36}: '牟',
+	{0x4C, 0x37}: '矛',
+	{0x4C, 0x38}: '霧',
+	{0x4C, 0x39}: '鵡',
+	{0x4C, 0x3A}: '椋',
+	{0x4C, 0x3B}: '婿',
+	{0x4C, 0x3C}: '娘',
+	{0x4C, 0x3D}: '冥',
+	{0x4C, 0x3E}: '名',
+	{0x4C, 0x3F}: '命',
+	{0x4C, 0x40}: '明',
+	{0x4C, 0x41}: '盟',
+	{0x4C, 0x42}: '迷',
+	{0x4C, 0x43}: '銘',
+	{0x4C, 0x44}: '鳴',
+	{0x4C, 0x45}: '姪',
+	{0x4C, 0x46}: '牝',
+	{0x4C, 0x47}: '滅',
+	{0x4C, 0x48}: '免',
+	{0x4C, 0x49}: '棉',
+	{0x4C, 0x4A}: '綿',
+	{0x4C, 0x4B}: '緬',
+	{0x4C, 0x4C}: '面',
+	{0x4C, 0x4D}: '麺',
+	{0x4C, 0x4E}: '摸',
+	{0x4C, 0x4F}: '模',
+	{0x4C, 0x50}: '茂',
+	{0x4C, 0x51}: '妄',
+	{0x4C, 0x52}: '孟',
+	{0x4C, 0x53}: '毛',
+	{0x4C, 0x54}: '猛',
+	{0x4C, 0x55}: '盲',
+	{0x4C, 0x56}: '網',
+	{0x4C, 0x57}: '耗',
+	{0x4C, 0x58}: '蒙',
+	{0x4C, 0x59}: '儲',
+	{0x4C, 0x5A}: '木',
+	{0x4C, 0x5B}: '黙',
+	{0x4C, 0x5C}: '目',
+	{0x4C, 0x5D}: '杢',
+	{0x4C, 0x5E}: '勿',
+	{0x4C, 0x5F}: '餅',
+	{0x4C, 0x60}: '尤',
+	{0x4C, 0x61}: '戻',
+	{0x4C, 0x62}: '籾',
+	{0x4C, 0x63}: '貰',
+	{0x4C, 0x64}: '問',
+	{0x4C, 0x65}: '悶',
+	{0x4C, 0x66}: '紋',
+	{0x4C, 0x67}: '門',
+	{0x4C, 0x68}: '匁',
+	{0x4C, 0x69}: '也',
+	{0x4C, 0x6A}: '冶',
+	{0x4C, 0x6B}: '夜',
+	{0x4C, 0x6C}: '爺',
+	{0x4C, 0x6D}: '耶',
+	{0x4C, 0x6E}: '野',
+	{0x4C, 0x6F}: '弥',
+	{0x4C, 0x70}: '矢',
+	{0x4C, 0x71}: '厄',
+	{0x4C, 0x72}: '役',
+	{0x4C, 0x73}: '約',
+	{0x4C, 0x74}: '薬',
+	{0x4C, 0x75}: '訳',
+	{0x4C, 0x76}: '躍',
+	{0x4C, 0x77}: '靖',
+	{0x4C, 0x78}: '柳',
+	{0x4C, 0x79}: '薮',
+	{0x4C, 0x7A}: '鑓',
+	{0x4C, 0x7B}: '愉',
+	{0x4C, 0x7C}: '愈',
+	{0x4C, 0x7D}: '油',
+	{0x4C, 0x7E}: '癒',
+	{0x4D, 0x21}: '諭',
+	{0x4D, 0x22}: '輸',
+	{0x4D, 0x23}: '唯',
+	{0x4D, 0x24}: '佑',
+	{0x4D, 0x25}: '優',
+	{0x4D, 0x26}: '勇',
+	{0x4D, 0x27}: '友',
+	{0x4D, 0x28}: '宥',
+	{0x4D, 0x29}: '幽',
+	{0x4D, 0x2A}: '悠',
+	{0x4D, 0x2B}: '憂',
+	{0x4D, 0x2C}: '揖',
+	{0x4D, 0x2D}: '有',
+	{0x4D, 0x2E}: '柚',
+	{0x4D, 0x2F}: '湧',
+	{0x4D, 0x30}: '涌',
+	{0x4D, 0x31}: '猶',
+	{0x4D, 0x32}: '猷',
+	{0x4D, 0x33}: '由',
+	{0x4D, 0x34}: '祐',
+	{0x4D, 0x35}: '裕',
+	{0x4D, 0x36}: '誘',
+	{0x4D, 0x37}: '遊',
+	{0x4D, 0x38}: '邑',
+	{0x4D, 0x39}: '郵',
+	{0x4D, 0x3A}: '雄',
+	{0x4D, 0x3B}: '融',
+	{0x4D, 0x3C}: '夕',
+	{0x4D, 0x3D}: '予',
+	{0x4D, 0x3E}: '余',
+	{0x4D, 0x3F}: '与',
+	{0x4D, 0x40}: '誉',
+	{0x4D, 0x41}: '輿',
+	{0x4D, 0x42}: '預',
+	{0x4D, 0x43}: '傭',
+	{0x4D, 0x44}: '幼',
+	{0x4D, 0x45}: '妖',
+	{0x4D, 0x46}: '容',
+	{0x4D, 0x47}: '庸',
+	{0x4D, 0x48}: '揚',
+	{0x4D, 0x49}: '揺',
+	{0x4D, 0x4A}: '擁',
+	{0x4D, 0x4B}: '曜',
+	{0x4D, 0x4C}: '楊',
+	{0x4D, 0x4D}: '様',
+	{0x4D, 0x4E}: '洋',
+	{0x4D, 0x4F}: '溶',
+	{0x4D, 0x50}: '熔',
+	{0x4D, 0x51}: '用',
+	{0x4D, 0x52}: '窯',
+	{0x4D, 0x53}: '羊',
+	{0x4D, 0x54}: '耀',
+	{0x4D, 0x55}: '葉',
+	{0x4D, 0x56}: '蓉',
+	{0x4D, 0x57}: '要',
+	{0x4D, 0x58}: '謡',
+	{0x4D, 0x59}: '踊',
+	{0x4D, 0x5A}: '遥',
+	{0x4D, 0x5B}: '陽',
+	{0x4D, 0x5C}: '養',
+	{0x4D, 0x5D}: '慾',
+	{0x4D, 0x5E}: '抑',
+	{0x4D, 0x5F}: '欲',
+	{0x4D, 0x60}: '沃',
+	{0x4D, 0x61}: '浴',
+	{0x4D, 0x62}: '翌',
+	{0x4D, 0x63}: '翼',
+	{0x4D, 0x64}: '淀',
+	{0x4D, 0x65}: '羅',
+	{0x4D, 0x66}: '螺',
+	{0x4D, 0x67}: '裸',
+	{0x4D, 0x68}: '来',
+	{0x4D, 0x69}: '莱',
+	{0x4D, 0x6A}: '頼',
+	{0x4D, 0x6B}: '雷',
+	{0x4D, 0x6C}: '洛',
+	{0x4D, 0x6D}: '絡',
+	{0x4D, 0x6E}: '落',
+	{0x4D, 0x6F}: '酪',
+	{0x4D, 0x70}: '乱',
+	{0x4D, 0x71}: '卵',
+	{0x4D, 0x72}: '嵐',
+	{0x4D, 0x73}: '欄',
+	{0x4D, 0x74}: '濫',
+	{0x4D, 0x75}: '藍',
+	{0x4D, 0x76}: '蘭',
+	{0x4D, 0x77}: '覧',
+	{0x4D, 0x78}: '利',
+	{0x4D, 0x79}: '吏',
+	{0x4D, 0x7A}: '履',
+	{0x4D, 0x7B}: '李',
+	{0x4D, 0x7C}: '梨',
+	{0x4D, 0x7D}: '理',
+	{0x4D, 0x7E}: '璃',
+	{0x4E, 0x21}: '痢',
+	{0x4E, 0x22}: '裏',
+	{0x4E, 0x23}: '裡',
+	{0x4E, 0x24}: '里',
+	{0x4E, 0x25}: '離',
+	{0x4E, 0x26}: '陸',
+	{0x4E, 0x27}: '律',
+	{0x4E, 0x28}: '率',
+	{0x4E, 0x29}: '立',
+	{0x4E, 0x2A}: '葎',
+	{0x4E, 0x2B}: '掠',
+	{0x4E, 0x2C}: '略',
+	{0x4E, 0x2D}: '劉',
+	{0x4E, 0x2E}: '流',
+	{0x4E, 0x2F}: '溜',
+	{0x4E, 0x30}: '琉',
+	{0x4E, 0x31}: '留',
+	{0x4E, 0x32}: '硫',
+	{0x4E, 0x33}: '粒',
+	{0x4E, 0x34}: '隆',
+	{0x4E, 0x35}: '竜',
+	{0x4E, 0x36}: '龍',
+	{0x4E, 0x37}: '侶',
+	{0x4E, 0x38}: '慮',
+	{0x4E, 0x39}: '旅',
+	{0x4E, 0x3A}: '虜',
+	{0x4E, 0x3B}: '了',
+	{0x4E, 0x3C}: '亮',
+	{0x4E, 0x3D}: '僚',
+	{0x4E, 0x3E}: '両',
+	{0x4E, 0x3F}: '凌',
+	{0x4E, 0x40}: '寮',
+	{0x4E, 0x41}: '料',
+	{0x4E, 0x42}: '梁',
+	{0x4E, 0x43}: '涼',
+	{0x4E, 0x44}: '猟',
+	{0x4E, 0x45}: '療',
+	{0x4E, 0x46}: '瞭',
+	{0x4E, 0x47}: '稜',
+	{0x4E, 0x48}: '糧',
+	{0x4E, 0x49}: '良',
+	{0x4E, 0x4A}: '諒',
+	{0x4E, 0x4B}: '遼',
+	{0x4E, 0x4C}: '量',
+	{0x4E, 0x4D}: '陵',
+	{0x4E, 0x4E}: '領',
+	{0x4E, 0x4F}: '力',
+	{0x4E, 0x50}: '緑',
+	{0x4E, 0x51}: '倫',
+	{0x4E, 0x52}: '厘',
+	{0x4E, 0x53}: '林',
+	{0x4E, 0x54}: '淋',
+	{0x4E, 0x55}: '燐',
+	{0x4E, 0x56}: '琳',
+	{0x4E, 0x57}: '臨',
+	{0x4E, 0x58}: '輪',
+	{0x4E, 0x59}: '隣',
+	{0x4E, 0x5A}: '鱗',
+	{0x4E, 0x5B}: '麟',
+	{0x4E, 0x5C}: '瑠',
+	{0x4E, 0x5D}: '塁',
+	{0x4E, 0x5E}: '涙',
+	{0x4E, 0x5F}: '累',
+	{0x4E, 0x60}: '類',
+	{0x4E, 0x61}: '令',
+	{0x4E, 0x62}: '伶',
+	{0x4E, 0x63}: '例',
+	{0x4E, 0x64}: '冷',
+	{0x4E, 0x65}: '励',
+	{0x4E, 0x66}: '嶺',
+	{0x4E, 0x67}: '怜',
+	{0x4E, 0x68}: '玲',
+	{0x4E, 0x69}: '礼',
+	{0x4E, 0x6A}: '苓',
+	{0x4E, 0x6B}: '鈴',
+	{0x4E, 0x6C}: '隷',
+	{0x4E, 0x6D}: '零',
+	{0x4E, 0x6E}: '霊',
+	{0x4E, 0x6F}: '麗',
+	{0x4E, 0x70}: '齢',
+	{0x4E, 0x71}: '暦',
+	{0x4E, 0x72}: '歴',
+	{0x4E, 0x73}: '列',
+	{0x4E, 0x74}: '劣',
+	{0x4E, 0x75}: '烈',
+	{0x4E, 0x76}: '裂',
+	{0x4E, 0x77}: '廉',
+	{0x4E, 0x78}: '恋',
+	{0x4E, 0x79}: '憐',
+	{0x4E, 0x7A}: '漣',
+	{0x4E, 0x7B}: '煉',
+	{0x4E, 0x7C}: '簾',
+	{0x4E, 0x7D}: '練',
+	{0x4E, 0x7E}: '聯',
+	{0x4F, 0x21}: '蓮',
+	{0x4F, 0x22}: '連',
+	{0x4F, 0x23}: '錬',
+	{0x4F, 0x24}: '呂',
+	{0x4F, 0x25}: '魯',
+	{0x4F, 0x26}: '櫓',
+	{0x4F, 0x27}: '炉',
+	{0x4F, 0x28}: '賂',
+	{0x4F, 0x29}: '路',
+	{0x4F, 0x2A}: '露',
+	{0x4F, 0x2B}: '労',
+	{0x4F, 0x2C}: '婁',
+	{0x4F, 0x2D}: '廊',
+	{0x4F, 0x2E}: '弄',
+	{0x4F, 0x2F}: '朗',
+	{0x4F, 0x30}: '楼',
+	{0x4F, 0x31}: '榔',
+	{0x4F, 0x32}: '浪',
+	{0x4F, 0x33}: '漏',
+	{0x4F, 0x34}: '牢',
+	{0x4F, 0x35}: '狼',
+	{0x4F, 0x36}: '篭',
+	{0x4F, 0x37}: '老',
+	{0x4F, 0x38}: '聾',
+	{0x4F, 0x39}: '蝋',
+	{0x4F, 0x3A}: '郎',
+	{0x4F, 0x3B}: '六',
+	{0x4F, 0x3C}: '麓',
+	{0x4F, 0x3D}: '禄',
+	{0x4F, 0x3E}: '肋',
+	{0x4F, 0x3F}: '録',
+	{0x4F, 0x40}: '論',
+	{0x4F, 0x41}: '倭',
+	{0x4F, 0x42}: '和',
+	{0x4F, 0x43}: '話',
+	{0x4F, 0x44}: '歪',
+	{0x4F, 0x45}: '賄',
+	{0x4F, 0x46}: '脇',
+	{0x4F, 0x47}: '惑',
+	{0x4F, 0x48}: '枠',
+	{0x4F, 0x49}: '鷲',
+	{0x4F, 0x4A}: '亙',
+	{0x4F, 0x4B}: '亘',
+	{0x4F, 0x4C}: '鰐',
+	{0x4F, 0x4D}: '詫',
+	{0x4F, 0x4E}: '藁',
+	{0x4F, 0x4F}: '蕨',
+	{0x4F, 0x50}: '椀',
+	{0x4F, 0x51}: '湾',
+	{0x4F, 0x52}: '碗',
+	{0x4F, 0x53}: '腕',
+	{0x50, 0x21}: '弌',
+	{0x50, 0x22}: '丐',
+	{0x50, 0x23}: '丕',
+	{0x50, 0x24}: '个',
+	{0x50, 0x25}: '丱',
+	{0x50, 0x26}: '丶',
+	{0x50, 0x27}: '丼',
+	{0x50, 0x28}: '丿',
+	{0x50, 0x29}: '乂',
+	{0x50, 0x2A}: '乖',
+	{0x50, 0x2B}: '乘',
+	{0x50, 0x2C}: '亂',
+	{0x50, 0x2D}: '亅',
+	{0x50, 0x2E}: '豫',
+	{0x50, 0x2F}: '亊',
+	{0x50, 0x30}: '舒',
+	{0x50, 0x31}: '弍',
+	{0x50, 0x32}: '于',
+	{0x50, 0x33}: '亞',
+	{0x50, 0x34}: '亟',
+	{0x50, 0x35}: '亠',
+	{0x50, 0x36}: '亢',
+	{0x50, 0x37}: '亰',
+	{0x50, 0x38}: '亳',
+	{0x50, 0x39}: '亶',
+	{0x50, 0x3A}: '从',
+	{0x50, 0x3B}: '仍',
+	{0x50, 0x3C}: '仄',
+	{0x50, 0x3D}: '仆',
+	{0x50, 0x3E}: '仂',
+	{0x50, 0x3F}: '仗',
+	{0x50, 0x40}: '仞',
+	{0x50, 0x41}: '仭',
+	{0x50, 0x42}: '仟',
+	{0x50, 0x43}: '价',
+	{0x50, 0x44}: '伉',
+	{0x50, 0x45}: '佚',
+	{0x50, 0x46}: '估',
+	{0x50, 0x47}: '佛',
+	{0x50, 0x48}: '佝',
+	{0x50, 0x49}: '佗',
+	{0x50, 0x4A}: '佇',
+	{0x50, 0x4B}: '佶',
+	{0x50, 0x4C}: '侈',
+	{0x50, 0x4D}: '侏',
+	{0x50, 0x4E}: '侘',
+	{0x50, 0x4F}: '佻',
+	{0x50, 0x50}: '佩',
+	{0x50, 0x51}: '佰',
+	{0x50, 0x52}: '侑',
+	{0x50, 0x53}: '佯',
+	{0x50, 0x54}: '來',
+	{0x50, 0x55}: '侖',
+	{0x50, 0x56}: '儘',
+	{0x50, 0x57}: '俔',
+	{0x50, 0x58}: '俟',
+	{0x50, 0x59}: '俎',
+	{0x50, 0x5A}: '俘',
+	{0x50, 0x5B}: '俛',
+	{0x50, 0x5C}: '俑',
+	{0x50, 0x5D}: '俚',
+	{0x50, 0x5E}: '俐',
+	{0x50, 0x5F}: '俤',
+	{0x50, 0x60}: '俥',
+	{0x50, 0x61}: '倚',
+	{0x50, 0x62}: '倨',
+	{0x50, 0x63}: '倔',
+	{0x50, 0x64}: '倪',
+	{0x50, 0x65}: '倥',
+	{0x50, 0x66}: '倅',
+	{0x50, 0x67}: '伜',
+	{0x50, 0x68}: '俶',
+	{0x50, 0x69}: '倡',
+	{0x50, 0x6A}: '倩',
+	{0x50, 0x6B}: '倬',
+	{0x50, 0x6C}: '俾',
+	{0x50, 0x6D}: '俯',
+	{0x50, 0x6E}: '們',
+	{0x50, 0x6F}: '倆',
+	{0x50, 0x70}: '偃',
+	{0x50, 0x71}: '假',
+	{0x50, 0x72}: '會',
+	{0x50, 0x73}: '偕',
+	{0x50, 0x74}: '偐',
+	{0x50, 0x75}: '偈',
+	{0x50, 0x76}: '做',
+	{0x50, 0x77}: '偖',
+	{0x50, 0x78}: '偬',
+	{0x50, 0x79}: '偸',
+	{0x50, 0x7A}: '傀',
+	{0x50, 0x7B}: '傚',
+	{0x50, 0x7C}: '傅',
+	{0x50, 0x7D}: '傴',
+	{0x50, 0x7E}: '傲',
+	{0x51, 0x21}: '僉',
+	{0x51, 0x22}: '僊',
+	{0x51, 0x23}: '傳',
+	{0x51, 0x24}: '僂',
+	{0x51, 0x25}: '僖',
+	{0x51, 0x26}: '僞',
+	{0x51, 0x27}: '僥',
+	{0x51, 0x28}: '僭',
+	{0x51, 0x29}: '僣',
+	{0x51, 0x2A}: '僮',
+	{0x51, 0x2B}: '價',
+	{0x51, 0x2C}: '僵',
+	{0x51, 0x2D}: '儉',
+	{0x51, 0x2E}: '儁',
+	{0x51, 0x2F}: '儂',
+	{0x51, 0x30}: '儖',
+	{0x51, 0x31}: '儕',
+	{0x51, 0x32}: '儔',
+	{0x51, 0x33}: '儚',
+	{0x51, 0x34}: '儡',
+	{0x51, 0x35}: '儺',
+	{0x51, 0x36}: '儷',
+	{0x51, 0x37}: '儼',
+	{0x51, 0x38}: '儻',
+	{0x51, 0x39}: '儿',
+	{0x51, 0x3A}: '兀',
+	{0x51, 0x3B}: '兒',
+	{0x51, 0x3C}: '兌',
+	{0x51, 0x3D}: '兔',
+	{0x51, 0x3E}: '兢',
+	{0x51, 0x3F}: '竸',
+	{0x51, 0x40}: '兩',
+	{0x51, 0x41}: '兪',
+	{0x51, 0x42}: '兮',
+	{0x51, 0x43}: '冀',
+	{0x51, 0x44}: '冂',
+	{0x51, 0x45}: '囘',
+	{0x51, 0x46}: '册',
+	{0x51, 0x47}: '冉',
+	{0x51, 0x48}: '冏',
+	{0x51, 0x49}: '冑',
+	{0x51, 0x4A}: '冓',
+	{0x51, 0x4B}: '冕',
+	{0x51, 0x4C}: '冖',
+	{0x51, 0x4D}: '冤',
+	{0x51, 0x4E}: '冦',
+	{0x51, 0x4F}: '冢',
+	{0x51, 0x50}: '冩',
+	{0x51, 0x51}: '冪',
+	{0x51, 0x52}: '冫',
+	{0x51, 0x53}: '决',
+	{0x51, 0x54}: '冱',
+	{0x51, 0x55}: '冲',
+	{0x51, 0x56}: '冰',
+	{0x51, 0x57}: '况',
+	{0x51, 0x58}: '冽',
+	{0x51, 0x59}: '凅',
+	{0x51, 0x5A}: '凉',
+	{0x51, 0x5B}: '凛',
+	{0x51, 0x5C}: '几',
+	{0x51, 0x5D}: '處',
+	{0x51, 0x5E}: '凩',
+	{0x51, 0x5F}: '凭',
+	{0x51, 0x60}: '凰',
+	{0x51, 0x61}: '凵',
+	{0x51, 0x62}: '凾',
+	{0x51, 0x63}: '刄',
+	{0x51, 0x64}: '刋',
+	{0x51, 0x65}: '刔',
+	{0x51, 0x66}: '刎',
+	{0x51, 0x67}: '刧',
+	{0x51, 0x68}: '刪',
+	{0x51, 0x69}: '刮',
+	{0x51, 0x6A}: '刳',
+	{0x51, 0x6B}: '刹',
+	{0x51, 0x6C}: '剏',
+	{0x51, 0x6D}: '剄',
+	{0x51, 0x6E}: '剋',
+	{0x51, 0x6F}: '剌',
+	{0x51, 0x70}: '剞',
+	{0x51, 0x71}: '剔',
+	{0x51, 0x72}: '剪',
+	{0x51, 0x73}: '剴',
+	{0x51, 0x74}: '剩',
+	{0x51, 0x75}: '剳',
+	{0x51, 0x76}: '剿',
+	{0x51, 0x77}: '剽',
+	{0x51, 0x78}: '劍',
+	{0x51, 0x79}: '劔',
+	{0x51, 0x7A}: '劒',
+	{0x51, 0x7B}: '剱',
+	{0x51, 0x7C}: '劈',
+	{0x51, 0x7D}: '劑',
+	{0x51, 0x7E}: '辨',
+	{0x52, 0x21}: '辧',
+	{0x52, 0x22}: '劬',
+	{0x52, 0x23}: '劭',
+	{0x52, 0x24}: '劼',
+	{0x52, 0x25}: '劵',
+	{0x52, 0x26}: '勁',
+	{0x52, 0x27}: '勍',
+	{0x52, 0x28}: '勗',
+	{0x52, 0x29}: '勞',
+	{0x52, 0x2A}: '勣',
+	{0x52, 0x2B}: '勦',
+	{0x52, 0x2C}: '飭',
+	{0x52, 0x2D}: '勠',
+	{0x52, 0x2E}: '勳',
+	{0x52, 0x2F}: '勵',
+	{0x52, 0x30}: '勸',
+	{0x52, 0x31}: '勹',
+	{0x52, 0x32}: '匆',
+	{0x52, 0x33}: '匈',
+	{0x52, 0x34}: '甸',
+	{0x52, 0x35}: '匍',
+	{0x52, 0x36}: '匐',
+	{0x52, 0x37}: '匏',
+	{0x52, 0x38}: '匕',
+	{0x52, 0x39}: '匚',
+	{0x52, 0x3A}: '匣',
+	{0x52, 0x3B}: '匯',
+	{0x52, 0x3C}: '匱',
+	{0x52, 0x3D}: '匳',
+	{0x52, 0x3E}: '匸',
+	{0x52, 0x3F}: '區',
+	{0x52, 0x40}: '卆',
+	{0x52, 0x41}: '卅',
+	{0x52, 0x42}: '丗',
+	{0x52, 0x43}: '卉',
+	{0x52, 0x44}: '卍',
+	{0x52, 0x45}: '凖',
+	{0x52, 0x46}: '卞',
+	{0x52, 0x47}: '卩',
+	{0x52, 0x48}: '卮',
+	{0x52, 0x49}: '夘',
+	{0x52, 0x4A}: '卻',
+	{0x52, 0x4B}: '卷',
+	{0x52, 0x4C}: '厂',
+	{0x52, 0x4D}: '厖',
+	{0x52, 0x4E}: '厠',
+	{0x52, 0x4F}: '厦',
+	{0x52, 0x50}: '厥',
+	{0x52, 0x51}: '厮',
+	{0x52, 0x52}: '厰',
+	{0x52, 0x53}: '厶',
+	{0x52, 0x54}: '參',
+	{0x52, 0x55}: '簒',
+	{0x52, 0x56}: '雙',
+	{0x52, 0x57}: '叟',
+	{0x52, 0x58}: '曼',
+	{0x52, 0x59}: '燮',
+	{0x52, 0x5A}: '叮',
+	{0x52, 0x5B}: '叨',
+	{0x52, 0x5C}: '叭',
+	{0x52, 0x5D}: '叺',
+	{0x52, 0x5E}: '吁',
+	{0x52, 0x5F}: '吽',
+	{0x52, 0x60}: '呀',
+	{0x52, 0x61}: '听',
+	{0x52, 0x62}: '吭',
+	{0x52, 0x63}: '吼',
+	{0x52, 0x64}: '吮',
+	{0x52, 0x65}: '吶',
+	{0x52, 0x66}: '吩',
+	{0x52, 0x67}: '吝',
+	{0x52, 0x68}: '呎',
+	{0x52, 0x69}: '咏',
+	{0x52, 0x6A}: '呵',
+	{0x52, 0x6B}: '咎',
+	{0x52, 0x6C}: '呟',
+	{0x52, 0x6D}: '呱',
+	{0x52, 0x6E}: '呷',
+	{0x52, 0x6F}: '呰',
+	{0x52, 0x70}: '咒',
+	{0x52, 0x71}: '呻',
+	{0x52, 0x72}: '咀',
+	{0x52, 0x73}: '呶',
+	{0x52, 0x74}: '咄',
+	{0x52, 0x75}: '咐',
+	{0x52, 0x76}: '咆',
+	{0x52, 0x77}: '哇',
+	{0x52, 0x78}: '咢',
+	{0x52, 0x79}: '咸',
+	{0x52, 0x7A}: '咥',
+	{0x52, 0x7B}: '咬',
+	{0x52, 0x7C}: '哄',
+	{0x52, 0x7D}: '哈',
+	{0x52, 0x7E}: '咨',
+	{0x53, 0x21}: '咫',
+	{0x53, 0x22}: '哂',
+	{0x53, 0x23}: '咤',
+	{0x53, 0x24}: '咾',
+	{0x53, 0x25}: '咼',
+	{0x53, 0x26}: '哘',
+	{0x53, 0x27}: '哥',
+	{0x53, 0x28}: '哦',
+	{0x53, 0x29}: '唏',
+	{0x53, 0x2A}: '唔',
+	{0x53, 0x2B}: '哽',
+	{0x53, 0x2C}: '哮',
+	{0x53, 0x2D}: '哭',
+	{0x53, 0x2E}: '哺',
+	{0x53, 0x2F}: '哢',
+	{0x53, 0x30}: '唹',
+	{0x53, 0x31}: '啀',
+	{0x53, 0x32}: '啣',
+	{0x53, 0x33}: '啌',
+	{0x53, 0x34}: '售',
+	{0x53, 0x35}: '啜',
+	{0x53, 0x36}: '啅',
+	{0x53, 0x37}: '啖',
+	{0x53, 0x38}: '啗',
+	{0x53, 0x39}: '唸',
+	{0x53, 0x3A}: '唳',
+	{0x53, 0x3B}: '啝',
+	{0x53, 0x3C}: '喙',
+	{0x53, 0x3D}: '喀',
+	{0x53, 0x3E}: '咯',
+	{0x53, 0x3F}: '喊',
+	{0x53, 0x40}: '喟',
+	{0x53, 0x41}: '啻',
+	{0x53, 0x42}: '啾',
+	{0x53, 0x43}: '喘',
+	{0x53, 0x44}: '喞',
+	{0x53, 0x45}: '單',
+	{0x53, 0x46}: '啼',
+	{0x53, 0x47}: '喃',
+	{0x53, 0x48}: '喩',
+	{0x53, 0x49}: '喇',
+	{0x53, 0x4A}: '喨',
+	{0x53, 0x4B}: '嗚',
+	{0x53, 0x4C}: '嗅',
+	{0x53, 0x4D}: '嗟',
+	{0x53, 0x4E}: '嗄',
+	{0x53, 0x4F}: '嗜',
+	{0x53, 0x50}: '嗤',
+	{0x53, 0x51}: '嗔',
+	{0x53, 0x52}: '嘔',
+	{0x53, 0x53}: '嗷',
+	{0x53, 0x54}: '嘖',
+	{0x53, 0x55}: '嗾',
+	{0x53, 0x56}: '嗽',
+	{0x53, 0x57}: '嘛',
+	{0x53, 0x58}: '嗹',
+	{0x53, 0x59}: '噎',
+	{0x53, 0x5A}: '噐',
+	{0x53, 0x5B}: '營',
+	{0x53, 0x5C}: '嘴',
+	{0x53, 0x5D}: '嘶',
+	{0x53, 0x5E}: '嘲',
+	{0x53, 0x5F}: '嘸',
+	{0x53, 0x60}: '噫',
+	{0x53, 0x61}: '噤',
+	{0x53, 0x62}: '嘯',
+	{0x53, 0x63}: '噬',
+	{0x53, 0x64}: '噪',
+	{0x53, 0x65}: '嚆',
+	{0x53, 0x66}: '嚀',
+	{0x53, 0x67}: '嚊',
+	{0x53, 0x68}: '嚠',
+	{0x53, 0x69}: '嚔',
+	{0x53, 0x6A}: '嚏',
+	{0x53, 0x6B}: '嚥',
+	{0x53, 0x6C}: '嚮',
+	{0x53, 0x6D}: '嚶',
+	{0x53, 0x6E}: '嚴',
+	{0x53, 0x6F}: '囂',
+	{0x53, 0x70}: '嚼',
+	{0x53, 0x71}: '囁',
+	{0x53, 0x72}: '囃',
+	{0x53, 0x73}: '囀',
+	{0x53, 0x74}: '囈',
+	{0x53, 0x75}: '囎',
+	{0x53, 0x76}: '囑',
+	{0x53, 0x77}: '囓',
+	{0x53, 0x78}: '囗',
+	{0x53, 0x79}: '囮',
+	{0x53, 0x7A}: '囹',
+	{0x53, 0x7B}: '圀',
+	{0x53, 0x7C}: '囿',
+	{0x53, 0x7D}: '圄',
+	{0x53, 0x7E}: '圉',
+	{0x54, 0x21}: '圈',
+	{0x54, 0x22}: '國',
+	{0x54, 0x23}: '圍',
+	{0x54, 0x24}: '圓',
+	{0x54, 0x25}: '團',
+	{0x54, 0x26}: '圖',
+	{0x54, 0x27}: '嗇',
+	{0x54, 0x28}: '圜',
+	{0x54, 0x29}: '圦',
+	{0x54, 0x2A}: '圷',
+	{0x54, 0x2B}: '圸',
+	{0x54, 0x2C}: '坎',
+	{0x54, 0x2D}: '圻',
+	{0x54, 0x2E}: '址',
+	{0x54, 0x2F}: '坏',
+	{0x54, 0x30}: '坩',
+	{0x54, 0x31}: '埀',
+	{0x54, 0x32}: '垈',
+	{0x54, 0x33}: '坡',
+	{0x54, 0x34}: '坿',
+	{0x54, 0x35}: '垉',
+	{0x54, 0x36}: '垓',
+	{0x54, 0x37}: '垠',
+	{0x54, 0x38}: '垳',
+	{0x54, 0x39}: '垤',
+	{0x54, 0x3A}: '垪',
+	{0x54, 0x3B}: '垰',
+	{0x54, 0x3C}: '埃',
+	{0x54, 0x3D}: '埆',
+	{0x54, 0x3E}: '埔',
+	{0x54, 0x3F}: '埒',
+	{0x54, 0x40}: '埓',
+	{0x54, 0x41}: '堊',
+	{0x54, 0x42}: '埖',
+	{0x54, 0x43}: '埣',
+	{0x54, 0x44}: '堋',
+	{0x54, 0x45}: '堙',
+	{0x54, 0x46}: '堝',
+	{0x54, 0x47}: '塲',
+	{0x54, 0x48}: '堡',
+	{0x54, 0x49}: '塢',
+	{0x54, 0x4A}: '塋',
+	{0x54, 0x4B}: '塰',
+	{0x54, 0x4C}: '毀',
+	{0x54, 0x4D}: '塒',
+	{0x54, 0x4E}: '堽',
+	{0x54, 0x4F}: '塹',
+	{0x54, 0x50}: '墅',
+	{0x54, 0x51}: '墹',
+	{0x54, 0x52}: '墟',
+	{0x54, 0x53}: '墫',
+	{0x54, 0x54}: '墺',
+	{0x54, 0x55}: '壞',
+	{0x54, 0x56}: '墻',
+	{0x54, 0x57}: '墸',
+	{0x54, 0x58}: '墮',
+	{0x54, 0x59}: '壅',
+	{0x54, 0x5A}: '壓',
+	{0x54, 0x5B}: '壑',
+	{0x54, 0x5C}: '壗',
+	{0x54, 0x5D}: '壙',
+	{0x54, 0x5E}: '壘',
+	{0x54, 0x5F}: '壥',
+	{0x54, 0x60}: '壜',
+	{0x54, 0x61}: '壤',
+	{0x54, 0x62}: '壟',
+	{0x54, 0x63}: '壯',
+	{0x54, 0x64}: '壺',
+	{0x54, 0x65}: '壹',
+	{0x54, 0x66}: '壻',
+	{0x54, 0x67}: '壼',
+	{0x54, 0x68}: '壽',
+	{0x54, 0x69}: '夂',
+	{0x54, 0x6A}: '夊',
+	{0x54, 0x6B}: '夐',
+	{0x54, 0x6C}: '夛',
+	{0x54, 0x6D}: '梦',
+	{0x54, 0x6E}: '夥',
+	{0x54, 0x6F}: '夬',
+	{0x54, 0x70}: '夭',
+	{0x54, 0x71}: '夲',
+	{0x54, 0x72}: '夸',
+	{0x54, 0x73}: '夾',
+	{0x54, 0x74}: '竒',
+	{0x54, 0x75}: '奕',
+	{0x54, 0x76}: '奐',
+	{0x54, 0x77}: '奎',
+	{0x54, 0x78}: '奚',
+	{0x54, 0x79}: '奘',
+	{0x54, 0x7A}: '奢',
+	{0x54, 0x7B}: '奠',
+	{0x54, 0x7C}: '奧',
+	{0x54, 0x7D}: '奬',
+	{0x54, 0x7E}: '奩',
+	{0x55, 0x21}: '奸',
+	{0x55, 0x22}: '妁',
+	{0x55, 0x23}: '妝',
+	{0x55, 0x24}: '佞',
+	{0x55, 0x25}: '侫',
+	{0x55, 0x26}: '妣',
+	{0x55, 0x27}: '妲',
+	{0x55, 0x28}: '姆',
+	{0x55, 0x29}: '姨',
+	{0x55, 0x2A}: '姜',
+	{0x55, 0x2B}: '妍',
+	{0x55, 0x2C}: '姙',
+	{0x55, 0x2D}: '姚',
+	{0x55, 0x2E}: '娥',
+	{0x55, 0x2F}: '娟',
+	{0x55, 0x30}: '娑',
+	{0x55, 0x31}: '娜',
+	{0x55, 0x32}: '娉',
+	{0x55, 0x33}: '娚',
+	{0x55, 0x34}: '婀',
+	{0x55, 0x35}: '婬',
+	{0x55, 0x36}: '婉',
+	{0x55, 0x37}: '娵',
+	{0x55, 0x38}: '娶',
+	{0x55, 0x39}: '婢',
+	{0x55, 0x3A}: '婪',
+	{0x55, 0x3B}: '媚',
+	{0x55, 0x3C}: '媼',
+	{0x55, 0x3D}: '媾',
+	{0x55, 0x3E}: '嫋',
+	{0x55, 0x3F}: '嫂',
+	{0x55, 0x40}: '媽',
+	{0x55, 0x41}: '嫣',
+	{0x55, 0x42}: '嫗',
+	{0x55, 0x43}: '嫦',
+	{0x55, 0x44}: '嫩',
+	{0x55, 0x45}: '嫖',
+	{0x55, 0x46}: '嫺',
+	{0x55, 0x47}: '嫻',
+	{0x55, 0x48}: '嬌',
+	{0x55, 0x49}: '嬋',
+	{0x55, 0x4A}: '嬖',
+	{0x55, 0x4B}: '嬲',
+	{0x55, 0x4C}: '嫐',
+	{0x55, 0x4D}: '嬪',
+	{0x55, 0x4E}: '嬶',
+	{0x55, 0x4F}: '嬾',
+	{0x55, 0x50}: '孃',
+	{0x55, 0x51}: '孅',
+	{0x55, 0x52}: '孀',
+	{0x55, 0x53}: '孑',
+	{0x55, 0x54}: '孕',
+	{0x55, 0x55}: '孚',
+	{0x55, 0x56}: '孛',
+	{0x55, 0x57}: '孥',
+	{0x55, 0x58}: '孩',
+	{0x55, 0x59}: '孰',
+	{0x55, 0x5A}: '孳',
+	{0x55, 0x5B}: '孵',
+	{0x55, 0x5C}: '學',
+	{0x55, 0x5D}: '斈',
+	{0x55, 0x5E}: '孺',
+	{0x55, 0x5F}: '宀',
+	{0x55, 0x60}: '它',
+	{0x55, 0x61}: '宦',
+	{0x55, 0x62}: '宸',
+	{0x55, 0x63}: '寃',
+	{0x55, 0x64}: '寇',
+	{0x55, 0x65}: '寉',
+	{0x55, 0x66}: '寔',
+	{0x55, 0x67}: '寐',
+	{0x55, 0x68}: '寤',
+	{0x55, 0x69}: '實',
+	{0x55, 0x6A}: '寢',
+	{0x55, 0x6B}: '寞',
+	{0x55, 0x6C}: '寥',
+	{0x55, 0x6D}: '寫',
+	{0x55, 0x6E}: '寰',
+	{0x55, 0x6F}: '寶',
+	{0x55, 0x70}: '寳',
+	{0x55, 0x71}: '尅',
+	{0x55, 0x72}: '將',
+	{0x55, 0x73}: '專',
+	{0x55, 0x74}: '對',
+	{0x55, 0x75}: '尓',
+	{0x55, 0x76}: '尠',
+	{0x55, 0x77}: '尢',
+	{0x55, 0x78}: '尨',
+	{0x55, 0x79}: '尸',
+	{0x55, 0x7A}: '尹',
+	{0x55, 0x7B}: '屁',
+	{0x55, 0x7C}: '屆',
+	{0x55, 0x7D}: '屎',
+	{0x55, 0x7E}: '屓',
+	{0x56, 0x21}: '屐',
+	{0x56, 0x22}: '屏',
+	{0x56, 0x23}: '孱',
+	{0x56, 0x24}: '屬',
+	{0x56, 0x25}: '屮',
+	{0x56, 0x26}: '乢',
+	{0x56, 0x27}: '屶',
+	{0x56, 0x28}: '屹',
+	{0x56, 0x29}: '岌',
+	{0x56, 0x2A}: '岑',
+	{0x56, 0x2B}: '岔',
+	{0x56, 0x2C}: '妛',
+	{0x56, 0x2D}: '岫',
+	{0x56, 0x2E}: '岻',
+	{0x56, 0x2F}: '岶',
+	{0x56, 0x30}: '岼',
+	{0x56, 0x31}: '岷',
+	{0x56, 0x32}: '峅',
+	{0x56, 0x33}: '岾',
+	{0x56, 0x34}: '峇',
+	{0x56, 0x35}: '峙',
+	{0x56, 0x36}: '峩',
+	{0x56, 0x37}: '峽',
+	{0x56, 0x38}: '峺',
+	{0x56, 0x39}: '峭',
+	{0x56, 0x3A}: '嶌',
+	{0x56, 0x3B}: '峪',
+	{0x56, 0x3C}: '崋',
+	{0x56, 0x3D}: '崕',
+	{0x56, 0x3E}: '崗',
+	{0x56, 0x3F}: '嵜',
+	{0x56, 0x40}: '崟',
+	{0x56, 0x41}: '崛',
+	{0x56, 0x42}: '崑',
+	{0x56, 0x43}: '崔',
+	{0x56, 0x44}: '崢',
+	{0x56, 0x45}: '崚',
+	{0x56, 0x46}: '崙',
+	{0x56, 0x47}: '崘',
+	{0x56, 0x48}: '嵌',
+	{0x56, 0x49}: '嵒',
+	{0x56, 0x4A}: '嵎',
+	{0x56, 0x4B}: '嵋',
+	{0x56, 0x4C}: '嵬',
+	{0x56, 0x4D}: '嵳',
+	{0x56, 0x4E}: '嵶',
+	{0x56, 0x4F}: '嶇',
+	{0x56, 0x50}: '嶄',
+	{0x56, 0x51}: '嶂',
+	{0x56, 0x52}: '嶢',
+	{0x56, 0x53}: '嶝',
+	{0x56, 0x54}: '嶬',
+	{0x56, 0x55}: '嶮',
+	{0x56, 0x56}: '嶽',
+	{0x56, 0x57}: '嶐',
+	{0x56, 0x58}: '嶷',
+	{0x56, 0x59}: '嶼',
+	{0x56, 0x5A}: '巉',
+	{0x56, 0x5B}: '巍',
+	{0x56, 0x5C}: '巓',
+	{0x56, 0x5D}: '巒',
+	{0x56, 0x5E}: '巖',
+	{0x56, 0x5F}: '巛',
+	{0x56, 0x60}: '巫',
+	{0x56, 0x61}: '已',
+	{0x56, 0x62}: '巵',
+	{0x56, 0x63}: '帋',
+	{0x56, 0x64}: '帚',
+	{0x56, 0x65}: '帙',
+	{0x56, 0x66}: '帑',
+	{0x56, 0x67}: '帛',
+	{0x56, 0x68}: '帶',
+	{0x56, 0x69}: '帷',
+	{0x56, 0x6A}: '幄',
+	{0x56, 0x6B}: '幃',
+	{0x56, 0x6C}: '幀',
+	{0x56, 0x6D}: '幎',
+	{0x56, 0x6E}: '幗',
+	{0x56, 0x6F}: '幔',
+	{0x56, 0x70}: '幟',
+	{0x56, 0x71}: '幢',
+	{0x56, 0x72}: '幤',
+	{0x56, 0x73}: '幇',
+	{0x56, 0x74}: '幵',
+	{0x56, 0x75}: '并',
+	{0x56, 0x76}: '幺',
+	{0x56, 0x77}: '麼',
+	{0x56, 0x78}: '广',
+	{0x56, 0x79}: '庠',
+	{0x56, 0x7A}: '廁',
+	{0x56, 0x7B}: '廂',
+	{0x56, 0x7C}: '廈',
+	{0x56, 0x7D}: '廐',
+	{0x56, 0x7E}: '廏',
+	{0x57, 0x21}: '廖',
+	{0x57, 0x22}: '廣',
+	{0x57, 0x23}: '廝',
+	{0x57, 0x24}: '廚',
+	{0x57, 0x25}: '廛',
+	{0x57, 0x26}: '廢',
+	{0x57, 0x27}: '廡',
+	{0x57, 0x28}: '廨',
+	{0x57, 0x29}: '廩',
+	{0x57, 0x2A}: '廬',
+	{0x57, 0x2B}: '廱',
+	{0x57, 0x2C}: '廳',
+	{0x57, 0x2D}: '廰',
+	{0x57, 0x2E}: '廴',
+	{0x57, 0x2F}: '廸',
+	{0x57, 0x30}: '廾',
+	{0x57, 0x31}: '弃',
+	{0x57, 0x32}: '弉',
+	{0x57, 0x33}: '彝',
+	{0x57, 0x34}: '彜',
+	{0x57, 0x35}: '弋',
+	{0x57, 0x36}: '弑',
+	{0x57, 0x37}: '弖',
+	{0x57, 0x38}: '弩',
+	{0x57, 0x39}: '弭',
+	{0x57, 0x3A}: '弸',
+	{0x57, 0x3B}: '彁',
+	{0x57, 0x3C}: '彈',
+	{0x57, 0x3D}: '彌',
+	{0x57, 0x3E}: '彎',
+	{0x57, 0x3F}: '弯',
+	{0x57, 0x40}: '彑',
+	{0x57, 0x41}: '彖',
+	{0x57, 0x42}: '彗',
+	{0x57, 0x43}: '彙',
+	{0x57, 0x44}: '彡',
+	{0x57, 0x45}: '彭',
+	{0x57, 0x46}: '彳',
+	{0x57, 0x47}: '彷',
+	{0x57, 0x48}: '徃',
+	{0x57, 0x49}: '徂',
+	{0x57, 0x4A}: '彿',
+	{0x57, 0x4B}: '徊',
+	{0x57, 0x4C}: '很',
+	{0x57, 0x4D}: '徑',
+	{0x57, 0x4E}: '徇',
+	{0x57, 0x4F}: '從',
+	{0x57, 0x50}: '徙',
+	{0x57, 0x51}: '徘',
+	{0x57, 0x52}: '徠',
+	{0x57, 0x53}: '徨',
+	{0x57, 0x54}: '徭',
+	{0x57, 0x55}: '徼',
+	{0x57, 0x56}: '忖',
+	{0x57, 0x57}: '忻',
+	{0x57, 0x58}: '忤',
+	{0x57, 0x59}: '忸',
+	{0x57, 0x5A}: '忱',
+	{0x57, 0x5B}: '忝',
+	{0x57, 0x5C}: '悳',
+	{0x57, 0x5D}: '忿',
+	{0x57, 0x5E}: '怡',
+	{0x57, 0x5F}: '恠',
+	{0x57, 0x60}: '怙',
+	{0x57, 0x61}: '怐',
+	{0x57, 0x62}: '怩',
+	{0x57, 0x63}: '怎',
+	{0x57, 0x64}: '怱',
+	{0x57, 0x65}: '怛',
+	{0x57, 0x66}: '怕',
+	{0x57, 0x67}: '怫',
+	{0x57, 0x68}: '怦',
+	{0x57, 0x69}: '怏',
+	{0x57, 0x6A}: '怺',
+	{0x57, 0x6B}: '恚',
+	{0x57, 0x6C}: '恁',
+	{0x57, 0x6D}: '恪',
+	{0x57, 0x6E}: '恷',
+	{0x57, 0x6F}: '恟',
+	{0x57, 0x70}: '恊',
+	{0x57, 0x71}: '恆',
+	{0x57, 0x72}: '恍',
+	{0x57, 0x73}: '恣',
+	{0x57, 0x74}: '恃',
+	{0x57, 0x75}: '恤',
+	{0x57, 0x76}: '恂',
+	{0x57, 0x77}: '恬',
+	{0x57, 0x78}: '恫',
+	{0x57, 0x79}: '恙',
+	{0x57, 0x7A}: '悁',
+	{0x57, 0x7B}: '悍',
+	{0x57, 0x7C}: '惧',
+	{0x57, 0x7D}: '悃',
+	{0x57, 0x7E}: '悚',
+	{0x58, 0x21}: '悄',
+	{0x58, 0x22}: '悛',
+	{0x58, 0x23}: '悖',
+	{0x58, 0x24}: '悗',
+	{0x58, 0x25}: '悒',
+	{0x58, 0x26}: '悧',
+	{0x58, 0x27}: '悋',
+	{0x58, 0x28}: '惡',
+	{0x58, 0x29}: '悸',
+	{0x58, 0x2A}: '惠',
+	{0x58, 0x2B}: '惓',
+	{0x58, 0x2C}: '悴',
+	{0x58, 0x2D}: '忰',
+	{0x58, 0x2E}: '悽',
+	{0x58, 0x2F}: '惆',
+	{0x58, 0x30}: '悵',
+	{0x58, 0x31}: '惘',
+	{0x58, 0x32}: '慍',
+	{0x58, 0x33}: '愕',
+	{0x58, 0x34}: '愆',
+	{0x58, 0x35}: '惶',
+	{0x58, 0x36}: '惷',
+	{0x58, 0x37}: '愀',
+	{0x58, 0x38}: '惴',
+	{0x58, 0x39}: '惺',
+	{0x58, 0x3A}: '愃',
+	{0x58, 0x3B}: '愡',
+	{0x58, 0x3C}: '惻',
+	{0x58, 0x3D}: '惱',
+	{0x58, 0x3E}: '愍',
+	{0x58, 0x3F}: '愎',
+	{0x58, 0x40}: '慇',
+	{0x58, 0x41}: '愾',
+	{0x58, 0x42}: '愨',
+	{0x58, 0x43}: '愧',
+	{0x58, 0x44}: '慊',
+	{0x58, 0x45}: '愿',
+	{0x58, 0x46}: '愼',
+	{0x58, 0x47}: '愬',
+	{0x58, 0x48}: '愴',
+	{0x58, 0x49}: '愽',
+	{0x58, 0x4A}: '慂',
+	{0x58, 0x4B}: '慄',
+	{0x58, 0x4C}: '慳',
+	{0x58, 0x4D}: '慷',
+	{0x58, 0x4E}: '慘',
+	{0x58, 0x4F}: '慙',
+	{0x58, 0x50}: '慚',
+	{0x58, 0x51}: '慫',
+	{0x58, 0x52}: '慴',
+	{0x58, 0x53}: '慯',
+	{0x58, 0x54}: '慥',
+	{0x58, 0x55}: '慱',
+	{0x58, 0x56}: '慟',
+	{0x58, 0x57}: '慝',
+	{0x58, 0x58}: '慓',
+	{0x58, 0x59}: '慵',
+	{0x58, 0x5A}: '憙',
+	{0x58, 0x5B}: '憖',
+	{0x58, 0x5C}: '憇',
+	{0x58, 0x5D}: '憬',
+	{0x58, 0x5E}: '憔',
+	{0x58, 0x5F}: '憚',
+	{0x58, 0x60}: '憊',
+	{0x58, 0x61}: '憑',
+	{0x58, 0x62}: '憫',
+	{0x58, 0x63}: '憮',
+	{0x58, 0x64}: '懌',
+	{0x58, 0x65}: '懊',
+	{0x58, 0x66}: '應',
+	{0x58, 0x67}: '懷',
+	{0x58, 0x68}: '懈',
+	{0x58, 0x69}: '懃',
+	{0x58, 0x6A}: '懆',
+	{0x58, 0x6B}: '憺',
+	{0x58, 0x6C}: '懋',
+	{0x58, 0x6D}: '罹',
+	{0x58, 0x6E}: '懍',
+	{0x58, 0x6F}: '懦',
+	{0x58, 0x70}: '懣',
+	{0x58, 0x71}: '懶',
+	{0x58, 0x72}: '懺',
+	{0x58, 0x73}: '懴',
+	{0x58, 0x74}: '懿',
+	{0x58, 0x75}: '懽',
+	{0x58, 0x76}: '懼',
+	{0x58, 0x77}: '懾',
+	{0x58, 0x78}: '戀',
+	{0x58, 0x79}: '戈',
+	{0x58, 0x7A}: '戉',
+	{0x58, 0x7B}: '戍',
+	{0x58, 0x7C}: '戌',
+	{0x58, 0x7D}: '戔',
+	{0x58, 0x7E}: '戛',
+	{0x59, 0x21}: '戞',
+	{0x59, 0x22}: '戡',
+	{0x59, 0x23}: '截',
+	{0x59, 0x24}: '戮',
+	{0x59, 0x25}: '戰',
+	{0x59, 0x26}: '戲',
+	{0x59, 0x27}: '戳',
+	{0x59, 0x28}: '扁',
+	{0x59, 0x29}: '扎',
+	{0x59, 0x2A}: '扞',
+	{0x59, 0x2B}: '扣',
+	{0x59, 0x2C}: '扛',
+	{0x59, 0x2D}: '扠',
+	{0x59, 0x2E}: '扨',
+	{0x59, 0x2F}: '扼',
+	{0x59, 0x30}: '抂',
+	{0x59, 0x31}: '抉',
+	{0x59, 0x32}: '找',
+	{0x59, 0x33}: '抒',
+	{0x59, 0x34}: '抓',
+	{0x59, 0x35}: '抖',
+	{0x59, 0x36}: '拔',
+	{0x59, 0x37}: '抃',
+	{0x59, 0x38}: '抔',
+	{0x59, 0x39}: '拗',
+	{0x59, 0x3A}: '拑',
+	{0x59, 0x3B}: '抻',
+	{0x59, 0x3C}: '拏',
+	{0x59, 0x3D}: '拿',
+	{0x59, 0x3E}: '拆',
+	{0x59, 0x3F}: '擔',
+	{0x59, 0x40}: '拈',
+	{0x59, 0x41}: '拜',
+	{0x59, 0x42}: '拌',
+	{0x59, 0x43}: '拊',
+	{0x59, 0x44}: '拂',
+	{0x59, 0x45}: '拇',
+	{0x59, 0x46}: '抛',
+	{0x59, 0x47}: '拉',
+	{0x59, 0x48}: '挌',
+	{0x59, 0x49}: '拮',
+	{0x59, 0x4A}: '拱',
+	{0x59, 0x4B}: '挧',
+	{0x59, 0x4C}: '挂',
+	{0x59, 0x4D}: '挈',
+	{0x59, 0x4E}: '拯',
+	{0x59, 0x4F}: '拵',
+	{0x59, 0x50}: '捐',
+	{0x59, 0x51}: '挾',
+	{0x59, 0x52}: '捍',
+	{0x59, 0x53}: '搜',
+	{0x59, 0x54}: '捏',
+	{0x59, 0x55}: '掖',
+	{0x59, 0x56}: '掎',
+	{0x59, 0x57}: '掀',
+	{0x59, 0x58}: '掫',
+	{0x59, 0x59}: '捶',
+	{0x59, 0x5A}: '掣',
+	{0x59, 0x5B}: '掏',
+	{0x59, 0x5C}: '掉',
+	{0x59, 0x5D}: '掟',
+	{0x59, 0x5E}: '掵',
+	{0x59, 0x5F}: '捫',
+	{0x59, 0x60}: '捩',
+	{0x59, 0x61}: '掾',
+	{0x59, 0x62}: '揩',
+	{0x59, 0x63}: '揀',
+	{0x59, 0x64}: '揆',
+	{0x59, 0x65}: '揣',
+	{0x59, 0x66}: '揉',
+	{0x59, 0x67}: '插',
+	{0x59, 0x68}: '揶',
+	{0x59, 0x69}: '揄',
+	{0x59, 0x6A}: '搖',
+	{0x59, 0x6B}: '搴',
+	{0x59, 0x6C}: '搆',
+	{0x59, 0x6D}: '搓',
+	{0x59, 0x6E}: '搦',
+	{0x59, 0x6F}: '搶',
+	{0x59, 0x70}: '攝',
+	{0x59, 0x71}: '搗',
+	{0x59, 0x72}: '搨',
+	{0x59, 0x73}: '搏',
+	{0x59, 0x74}: '摧',
+	{0x59, 0x75}: '摯',
+	{0x59, 0x76}: '摶',
+	{0x59, 0x77}: '摎',
+	{0x59, 0x78}: '攪',
+	{0x59, 0x79}: '撕',
+	{0x59, 0x7A}: '撓',
+	{0x59, 0x7B}: '撥',
+	{0x59, 0x7C}: '撩',
+	{0x59, 0x7D}: '撈',
+	{0x59, 0x7E}: '撼',
+	{0x5A, 0x21}: '據',
+	{0x5A, 0x22}: '擒',
+	{0x5A, 0x23}: '擅',
+	{0x5A, 0x24}: '擇',
+	{0x5A, 0x25}: '撻',
+	{0x5A, 0x26}: '擘',
+	{0x5A, 0x27}: '擂',
+	{0x5A, 0x28}: '擱',
+	{0x5A, 0x29}: '擧',
+	{0x5A, 0x2A}: '舉',
+	{0x5A, 0x2B}: '擠',
+	{0x5A, 0x2C}: '擡',
+	{0x5A, 0x2D}: '抬',
+	{0x5A, 0x2E}: '擣',
+	{0x5A, 0x2F}: '擯',
+	{0x5A, 0x30}: '攬',
+	{0x5A, 0x31}: '擶',
+	{0x5A, 0x32}: '擴',
+	{0x5A, 0x33}: '擲',
+	{0x5A, 0x34}: '擺',
+	{0x5A, 0x35}: '攀',
+	{0x5A, 0x36}: '擽',
+	{0x5A, 0x37}: '攘',
+	{0x5A, 0x38}: '攜',
+	{0x5A, 0x39}: '攅',
+	{0x5A, 0x3A}: '攤',
+	{0x5A, 0x3B}: '攣',
+	{0x5A, 0x3C}: '攫',
+	{0x5A, 0x3D}: '攴',
+	{0x5A, 0x3E}: '攵',
+	{0x5A, 0x3F}: '攷',
+	{0x5A, 0x40}: '收',
+	{0x5A, 0x41}: '攸',
+	{0x5A, 0x42}: '畋',
+	{0x5A, 0x43}: '效',
+	{0x5A, 0x44}: '敖',
+	{0x5A, 0x45}: '敕',
+	{0x5A, 0x46}: '敍',
+	{0x5A, 0x47}: '敘',
+	{0x5A, 0x48}: '敞',
+	{0x5A, 0x49}: '敝',
+	{0x5A, 0x4A}: '敲',
+	{0x5A, 0x4B}: '數',
+	{0x5A, 0x4C}: '斂',
+	{0x5A, 0x4D}: '斃',
+	{0x5A, 0x4E}: '變',
+	{0x5A, 0x4F}: '斛',
+	{0x5A, 0x50}: '斟',
+	{0x5A, 0x51}: '斫',
+	{0x5A, 0x52}: '斷',
+	{0x5A, 0x53}: '旃',
+	{0x5A, 0x54}: '旆',
+	{0x5A, 0x55}: '旁',
+	{0x5A, 0x56}: '旄',
+	{0x5A, 0x57}: '旌',
+	{0x5A, 0x58}: '旒',
+	{0x5A, 0x59}: '旛',
+	{0x5A, 0x5A}: '旙',
+	{0x5A, 0x5B}: '无',
+	{0x5A, 0x5C}: '旡',
+	{0x5A, 0x5D}: '旱',
+	{0x5A, 0x5E}: '杲',
+	{0x5A, 0x5F}: '昊',
+	{0x5A, 0x60}: '昃',
+	{0x5A, 0x61}: '旻',
+	{0x5A, 0x62}: '杳',
+	{0x5A, 0x63}: '昵',
+	{0x5A, 0x64}: '昶',
+	{0x5A, 0x65}: '昴',
+	{0x5A, 0x66}: '昜',
+	{0x5A, 0x67}: '晏',
+	{0x5A, 0x68}: '晄',
+	{0x5A, 0x69}: '晉',
+	{0x5A, 0x6A}: '晁',
+	{0x5A, 0x6B}: '晞',
+	{0x5A, 0x6C}: '晝',
+	{0x5A, 0x6D}: '晤',
+	{0x5A, 0x6E}: '晧',
+	{0x5A, 0x6F}: '晨',
+	{0x5A, 0x70}: '晟',
+	{0x5A, 0x71}: '晢',
+	{0x5A, 0x72}: '晰',
+	{0x5A, 0x73}: '暃',
+	{0x5A, 0x74}: '暈',
+	{0x5A, 0x75}: '暎',
+	{0x5A, 0x76}: '暉',
+	{0x5A, 0x77}: '暄',
+	{0x5A, 0x78}: '暘',
+	{0x5A, 0x79}: '暝',
+	{0x5A, 0x7A}: '曁',
+	{0x5A, 0x7B}: '暹',
+	{0x5A, 0x7C}: '曉',
+	{0x5A, 0x7D}: '暾',
+	{0x5A, 0x7E}: '暼',
+	{0x5B, 0x21}: '曄',
+	{0x5B, 0x22}: '暸',
+	{0x5B, 0x23}: '曖',
+	{0x5B, 0x24}: '曚',
+	{0x5B, 0x25}: '曠',
+	{0x5B, 0x26}: '昿',
+	{0x5B, 0x27}: '曦',
+	{0x5B, 0x28}: '曩',
+	{0x5B, 0x29}: '曰',
+	{0x5B, 0x2A}: '曵',
+	{0x5B, 0x2B}: '曷',
+	{0x5B, 0x2C}: '朏',
+	{0x5B, 0x2D}: '朖',
+	{0x5B, 0x2E}: '朞',
+	{0x5B, 0x2F}: '朦',
+	{0x5B, 0x30}: '朧',
+	{0x5B, 0x31}: '霸',
+	{0x5B, 0x32}: '朮',
+	{0x5B, 0x33}: '朿',
+	{0x5B, 0x34}: '朶',
+	{0x5B, 0x35}: '杁',
+	{0x5B, 0x36}: '朸',
+	{0x5B, 0x37}: '朷',
+	{0x5B, 0x38}: '杆',
+	{0x5B, 0x39}: '杞',
+	{0x5B, 0x3A}: '杠',
+	{0x5B, 0x3B}: '杙',
+	{0x5B, 0x3C}: '杣',
+	{0x5B, 0x3D}: '杤',
+	{0x5B, 0x3E}: '枉',
+	{0x5B, 0x3F}: '杰',
+	{0x5B, 0x40}: '枩',
+	{0x5B, 0x41}: '杼',
+	{0x5B, 0x42}: '杪',
+	{0x5B, 0x43}: '枌',
+	{0x5B, 0x44}: '枋',
+	{0x5B, 0x45}: '枦',
+	{0x5B, 0x46}: '枡',
+	{0x5B, 0x47}: '枅',
+	{0x5B, 0x48}: '枷',
+	{0x5B, 0x49}: '柯',
+	{0x5B, 0x4A}: '枴',
+	{0x5B, 0x4B}: '柬',
+	{0x5B, 0x4C}: '枳',
+	{0x5B, 0x4D}: '柩',
+	{0x5B, 0x4E}: '枸',
+	{0x5B, 0x4F}: '柤',
+	{0x5B, 0x50}: '柞',
+	{0x5B, 0x51}: '柝',
+	{0x5B, 0x52}: '柢',
+	{0x5B, 0x53}: '柮',
+	{0x5B, 0x54}: '枹',
+	{0x5B, 0x55}: '柎',
+	{0x5B, 0x56}: '柆',
+	{0x5B, 0x57}: '柧',
+	{0x5B, 0x58}: '檜',
+	{0x5B, 0x59}: '栞',
+	{0x5B, 0x5A}: '框',
+	{0x5B, 0x5B}: '栩',
+	{0x5B, 0x5C}: '桀',
+	{0x5B, 0x5D}: '桍',
+	{0x5B, 0x5E}: '栲',
+	{0x5B, 0x5F}: '桎',
+	{0x5B, 0x60}: '梳',
+	{0x5B, 0x61}: '栫',
+	{0x5B, 0x62}: '桙',
+	{0x5B, 0x63}: '档',
+	{0x5B, 0x64}: '桷',
+	{0x5B, 0x65}: '桿',
+	{0x5B, 0x66}: '梟',
+	{0x5B, 0x67}: '梏',
+	{0x5B, 0x68}: '梭',
+	{0x5B, 0x69}: '梔',
+	{0x5B, 0x6A}: '條',
+	{0x5B, 0x6B}: '梛',
+	{0x5B, 0x6C}: '梃',
+	{0x5B, 0x6D}: '檮',
+	{0x5B, 0x6E}: '梹',
+	{0x5B, 0x6F}: '桴',
+	{0x5B, 0x70}: '梵',
+	{0x5B, 0x71}: '梠',
+	{0x5B, 0x72}: '梺',
+	{0x5B, 0x73}: '椏',
+	{0x5B, 0x74}: '梍',
+	{0x5B, 0x75}: '桾',
+	{0x5B, 0x76}: '椁',
+	{0x5B, 0x77}: '棊',
+	{0x5B, 0x78}: '椈',
+	{0x5B, 0x79}: '棘',
+	{0x5B, 0x7A}: '椢',
+	{0x5B, 0x7B}: '椦',
+	{0x5B, 0x7C}: '棡',
+	{0x5B, 0x7D}: '椌',
+	{0x5B, 0x7E}: '棍',
+	{0x5C, 0x21}: '棔',
+	{0x5C, 0x22}: '棧',
+	{0x5C, 0x23}: '棕',
+	{0x5C, 0x24}: '椶',
+	{0x5C, 0x25}: '椒',
+	{0x5C, 0x26}: '椄',
+	{0x5C, 0x27}: '棗',
+	{0x5C, 0x28}: '棣',
+	{0x5C, 0x29}: '椥',
+	{0x5C, 0x2A}: '棹',
+	{0x5C, 0x2B}: '棠',
+	{0x5C, 0x2C}: '棯',
+	{0x5C, 0x2D}: '椨',
+	{0x5C, 0x2E}: '椪',
+	{0x5C, 0x2F}: '椚',
+	{0x5C, 0x30}: '椣',
+	{0x5C, 0x31}: '椡',
+	{0x5C, 0x32}: '棆',
+	{0x5C, 0x33}: '楹',
+	{0x5C, 0x34}: '楷',
+	{0x5C, 0x35}: '楜',
+	{0x5C, 0x36}: '楸',
+	{0x5C, 0x37}: '楫',
+	{0x5C, 0x38}: '楔',
+	{0x5C, 0x39}: '楾',
+	{0x5C, 0x3A}: '楮',
+	{0x5C, 0x3B}: '椹',
+	{0x5C, 0x3C}: '楴',
+	{0x5C, 0x3D}: '椽',
+	{0x5C, 0x3E}: '楙',
+	{0x5C, 0x3F}: '椰',
+	{0x5C, 0x40}: '楡',
+	{0x5C, 0x41}: '楞',
+	{0x5C, 0x42}: '楝',
+	{0x5C, 0x43}: '榁',
+	{0x5C, 0x44}: '楪',
+	{0x5C, 0x45}: '榲',
+	{0x5C, 0x46}: '榮',
+	{0x5C, 0x47}: '槐',
+	{0x5C, 0x48}: '榿',
+	{0x5C, 0x49}: '槁',
+	{0x5C, 0x4A}: '槓',
+	{0x5C, 0x4B}: '榾',
+	{0x5C, 0x4C}: '槎',
+	{0x5C, 0x4D}: '寨',
+	{0x5C, 0x4E}: '槊',
+	{0x5C, 0x4F}: '槝',
+	{0x5C, 0x50}: '榻',
+	{0x5C, 0x51}: '槃',
+	{0x5C, 0x52}: '榧',
+	{0x5C, 0x53}: '樮',
+	{0x5C, 0x54}: '榑',
+	{0x5C, 0x55}: '榠',
+	{0x5C, 0x56}: '榜',
+	{0x5C, 0x57}: '榕',
+	{0x5C, 0x58}: '榴',
+	{0x5C, 0x59}: '槞',
+	{0x5C, 0x5A}: '槨',
+	{0x5C, 0x5B}: '樂',
+	{0x5C, 0x5C}: '樛',
+	{0x5C, 0x5D}: '槿',
+	{0x5C, 0x5E}: '權',
+	{0x5C, 0x5F}: '槹',
+	{0x5C, 0x60}: '槲',
+	{0x5C, 0x61}: '槧',
+	{0x5C, 0x62}: '樅',
+	{0x5C, 0x63}: '榱',
+	{0x5C, 0x64}: '樞',
+	{0x5C, 0x65}: '槭',
+	{0x5C, 0x66}: '樔',
+	{0x5C, 0x67}: '槫',
+	{0x5C, 0x68}: '樊',
+	{0x5C, 0x69}: '樒',
+	{0x5C, 0x6A}: '櫁',
+	{0x5C, 0x6B}: '樣',
+	{0x5C, 0x6C}: '樓',
+	{0x5C, 0x6D}: '橄',
+	{0x5C, 0x6E}: '樌',
+	{0x5C, 0x6F}: '橲',
+	{0x5C, 0x70}: '樶',
+	{0x5C, 0x71}: '橸',
+	{0x5C, 0x72}: '橇',
+	{0x5C, 0x73}: '橢',
+	{0x5C, 0x74}: '橙',
+	{0x5C, 0x75}: '橦',
+	{0x5C, 0x76}: '橈',
+	{0x5C, 0x77}: '樸',
+	{0x5C, 0x78}: '樢',
+	{0x5C, 0x79}: '檐',
+	{0x5C, 0x7A}: '檍',
+	{0x5C, 0x7B}: '檠',
+	{0x5C, 0x7C}: '檄',
+	{0x5C, 0x7D}: '檢',
+	{0x5C, 0x7E}: '檣',
+	{0x5D, 0x21}: '檗',
+	{0x5D, 0x22}: '蘗',
+	{0x5D, 0x23}: '檻',
+	{0x5D, 0x24}: '櫃',
+	{0x5D, 0x25}: '櫂',
+	{0x5D, 0x26}: '檸',
+	{0x5D, 0x27}: '檳',
+	{0x5D, 0x28}: '檬',
+	{0x5D, 0x29}: '櫞',
+	{0x5D, 0x2A}: '櫑',
+	{0x5D, 0x2B}: '櫟',
+	{0x5D, 0x2C}: '檪',
+	{0x5D, 0x2D}: '櫚',
+	{0x5D, 0x2E}: '櫪',
+	{0x5D, 0x2F}: '櫻',
+	{0x5D, 0x30}: '欅',
+	{0x5D, 0x31}: '蘖',
+	{0x5D, 0x32}: '櫺',
+	{0x5D, 0x33}: '欒',
+	{0x5D, 0x34}: '欖',
+	{0x5D, 0x35}: '鬱',
+	{0x5D, 0x36}: '欟',
+	{0x5D, 0x37}: '欸',
+	{0x5D, 0x38}: '欷',
+	{0x5D, 0x39}: '盜',
+	{0x5D, 0x3A}: '欹',
+	{0x5D, 0x3B}: '飮',
+	{0x5D, 0x3C}: '歇',
+	{0x5D, 0x3D}: '歃',
+	{0x5D, 0x3E}: '歉',
+	{0x5D, 0x3F}: '歐',
+	{0x5D, 0x40}: '歙',
+	{0x5D, 0x41}: '歔',
+	{0x5D, 0x42}: '歛',
+	{0x5D, 0x43}: '歟',
+	{0x5D, 0x44}: '歡',
+	{0x5D, 0x45}: '歸',
+	{0x5D, 0x46}: '歹',
+	{0x5D, 0x47}: '歿',
+	{0x5D, 0x48}: '殀',
+	{0x5D, 0x49}: '殄',
+	{0x5D, 0x4A}: '殃',
+	{0x5D, 0x4B}: '殍',
+	{0x5D, 0x4C}: '殘',
+	{0x5D, 0x4D}: '殕',
+	{0x5D, 0x4E}: '殞',
+	{0x5D, 0x4F}: '殤',
+	{0x5D, 0x50}: '殪',
+	{0x5D, 0x51}: '殫',
+	{0x5D, 0x52}: '殯',
+	{0x5D, 0x53}: '殲',
+	{0x5D, 0x54}: '殱',
+	{0x5D, 0x55}: '殳',
+	{0x5D, 0x56}: '殷',
+	{0x5D, 0x57}: '殼',
+	{0x5D, 0x58}: '毆',
+	{0x5D, 0x59}: '毋',
+	{0x5D, 0x5A}: '毓',
+	{0x5D, 0x5B}: '毟',
+	{0x5D, 0x5C}: '毬',
+	{0x5D, 0x5D}: '毫',
+	{0x5D, 0x5E}: '毳',
+	{0x5D, 0x5F}: '毯',
+	{0x5D, 0x60}: '麾',
+	{0x5D, 0x61}: '氈',
+	{0x5D, 0x62}: '氓',
+	{0x5D, 0x63}: '气',
+	{0x5D, 0x64}: '氛',
+	{0x5D, 0x65}: '氤',
+	{0x5D, 0x66}: '氣',
+	{0x5D, 0x67}: '汞',
+	{0x5D, 0x68}: '汕',
+	{0x5D, 0x69}: '汢',
+	{0x5D, 0x6A}: '汪',
+	{0x5D, 0x6B}: '沂',
+	{0x5D, 0x6C}: '沍',
+	{0x5D, 0x6D}: '沚',
+	{0x5D, 0x6E}: '沁',
+	{0x5D, 0x6F}: '沛',
+	{0x5D, 0x70}: '汾',
+	{0x5D, 0x71}: '汨',
+	{0x5D, 0x72}: '汳',
+	{0x5D, 0x73}: '沒',
+	{0x5D, 0x74}: '沐',
+	{0x5D, 0x75}: '泄',
+	{0x5D, 0x76}: '泱',
+	{0x5D, 0x77}: '泓',
+	{0x5D, 0x78}: '沽',
+	{0x5D, 0x79}: '泗',
+	{0x5D, 0x7A}: '泅',
+	{0x5D, 0x7B}: '泝',
+	{0x5D, 0x7C}: '沮',
+	{0x5D, 0x7D}: '沱',
+	{0x5D, 0x7E}: '沾',
+	{0x5E, 0x21}: '沺',
+	{0x5E, 0x22}: '泛',
+	{0x5E, 0x23}: '泯',
+	{0x5E, 0x24}: '泙',
+	{0x5E, 0x25}: '泪',
+	{0x5E, 0x26}: '洟',
+	{0x5E, 0x27}: '衍',
+	{0x5E, 0x28}: '洶',
+	{0x5E, 0x29}: '洫',
+	{0x5E, 0x2A}: '洽',
+	{0x5E, 0x2B}: '洸',
+	{0x5E, 0x2C}: '洙',
+	{0x5E, 0x2D}: '洵',
+	{0x5E, 0x2E}: '洳',
+	{0x5E, 0x2F}: '洒',
+	{0x5E, 0x30}: '洌',
+	{0x5E, 0x31}: '浣',
+	{0x5E, 0x32}: '涓',
+	{0x5E, 0x33}: '浤',
+	{0x5E, 0x34}: '浚',
+	{0x5E, 0x35}: '浹',
+	{0x5E, 0x36}: '浙',
+	{0x5E, 0x37}: '涎',
+	{0x5E, 0x38}: '涕',
+	{0x5E, 0x39}: '濤',
+	{0x5E, 0x3A}: '涅',
+	{0x5E, 0x3B}: '淹',
+	{0x5E, 0x3C}: '渕',
+	{0x5E, 0x3D}: '渊',
+	{0x5E, 0x3E}: '涵',
+	{0x5E, 0x3F}: '淇',
+	{0x5E, 0x40}: '淦',
+	{0x5E, 0x41}: '涸',
+	{0x5E, 0x42}: '淆',
+	{0x5E, 0x43}: '淬',
+	{0x5E, 0x44}: '淞',
+	{0x5E, 0x45}: '淌',
+	{0x5E, 0x46}: '淨',
+	{0x5E, 0x47}: '淒',
+	{0x5E, 0x48}: '淅',
+	{0x5E, 0x49}: '淺',
+	{0x5E, 0x4A}: '淙',
+	{0x5E, 0x4B}: '淤',
+	{0x5E, 0x4C}: '淕',
+	{0x5E, 0x4D}: '淪',
+	{0x5E, 0x4E}: '淮',
+	{0x5E, 0x4F}: '渭',
+	{0x5E, 0x50}: '湮',
+	{0x5E, 0x51}: '渮',
+	{0x5E, 0x52}: '渙',
+	{0x5E, 0x53}: '湲',
+	{0x5E, 0x54}: '湟',
+	{0x5E, 0x55}: '渾',
+	{0x5E, 0x56}: '渣',
+	{0x5E, 0x57}: '湫',
+	{0x5E, 0x58}: '渫',
+	{0x5E, 0x59}: '湶',
+	{0x5E, 0x5A}: '湍',
+	{0x5E, 0x5B}: '渟',
+	{0x5E, 0x5C}: '湃',
+	{0x5E, 0x5D}: '渺',
+	{0x5E, 0x5E}: '湎',
+	{0x5E, 0x5F}: '渤',
+	{0x5E, 0x60}: '滿',
+	{0x5E, 0x61}: '渝',
+	{0x5E, 0x62}: '游',
+	{0x5E, 0x63}: '溂',
+	{0x5E, 0x64}: '溪',
+	{0x5E, 0x65}: '溘',
+	{0x5E, 0x66}: '滉',
+	{0x5E, 0x67}: '溷',
+	{0x5E, 0x68}: '滓',
+	{0x5E, 0x69}: '溽',
+	{0x5E, 0x6A}: '溯',
+	{0x5E, 0x6B}: '滄',
+	{0x5E, 0x6C}: '溲',
+	{0x5E, 0x6D}: '滔',
+	{0x5E, 0x6E}: '滕',
+	{0x5E, 0x6F}: '溏',
+	{0x5E, 0x70}: '溥',
+	{0x5E, 0x71}: '滂',
+	{0x5E, 0x72}: '溟',
+	{0x5E, 0x73}: '潁',
+	{0x5E, 0x74}: '漑',
+	{0x5E, 0x75}: '灌',
+	{0x5E, 0x76}: '滬',
+	{0x5E, 0x77}: '滸',
+	{0x5E, 0x78}: '滾',
+	{0x5E, 0x79}: '漿',
+	{0x5E, 0x7A}: '滲',
+	{0x5E, 0x7B}: '漱',
+	{0x5E, 0x7C}: '滯',
+	{0x5E, 0x7D}: '漲',
+	{0x5E, 0x7E}: '滌',
+	{0x5F, 0x21}: '漾',
+	{0x5F, 0x22}: '漓',
+	{0x5F, 0x23}: '滷',
+	{0x5F, 0x24}: '澆',
+	{0x5F, 0x25}: '潺',
+	{0x5F, 0x26}: '潸',
+	{0x5F, 0x27}: '澁',
+	{0x5F, 0x28}: '澀',
+	{0x5F, 0x29}: '潯',
+	{0x5F, 0x2A}: '潛',
+	{0x5F, 0x2B}: '濳',
+	{0x5F, 0x2C}: '潭',
+	{0x5F, 0x2D}: '澂',
+	{0x5F, 0x2E}: '潼',
+	{0x5F, 0x2F}: '潘',
+	{0x5F, 0x30}: '澎',
+	{0x5F, 0x31}: '澑',
+	{0x5F, 0x32}: '濂',
+	{0x5F, 0x33}: '潦',
+	{0x5F, 0x34}: '澳',
+	{0x5F, 0x35}: '澣',
+	{0x5F, 0x36}: '澡',
+	{0x5F, 0x37}: '澤',
+	{0x5F, 0x38}: '澹',
+	{0x5F, 0x39}: '濆',
+	{0x5F, 0x3A}: '澪',
+	{0x5F, 0x3B}: '濟',
+	{0x5F, 0x3C}: '濕',
+	{0x5F, 0x3D}: '濬',
+	{0x5F, 0x3E}: '濔',
+	{0x5F, 0x3F}: '濘',
+	{0x5F, 0x40}: '濱',
+	{0x5F, 0x41}: '濮',
+	{0x5F, 0x42}: '濛',
+	{0x5F, 0x43}: '瀉',
+	{0x5F, 0x44}: '瀋',
+	{0x5F, 0x45}: '濺',
+	{0x5F, 0x46}: '瀑',
+	{0x5F, 0x47}: '瀁',
+	{0x5F, 0x48}: '瀏',
+	{0x5F, 0x49}: '濾',
+	{0x5F, 0x4A}: '瀛',
+	{0x5F, 0x4B}: '瀚',
+	{0x5F, 0x4C}: '潴',
+	{0x5F, 0x4D}: '瀝',
+	{0x5F, 0x4E}: '瀘',
+	{0x5F, 0x4F}: '瀟',
+	{0x5F, 0x50}: '瀰',
+	{0x5F, 0x51}: '瀾',
+	{0x5F, 0x52}: '瀲',
+	{0x5F, 0x53}: '灑',
+	{0x5F, 0x54}: '灣',
+	{0x5F, 0x55}: '炙',
+	{0x5F, 0x56}: '炒',
+	{0x5F, 0x57}: '炯',
+	{0x5F, 0x58}: '烱',
+	{0x5F, 0x59}: '炬',
+	{0x5F, 0x5A}: '炸',
+	{0x5F, 0x5B}: '炳',
+	{0x5F, 0x5C}: '炮',
+	{0x5F, 0x5D}: '烟',
+	{0x5F, 0x5E}: '烋',
+	{0x5F, 0x5F}: '烝',
+	{0x5F, 0x60}: '烙',
+	{0x5F, 0x61}: '焉',
+	{0x5F, 0x62}: '烽',
+	{0x5F, 0x63}: '焜',
+	{0x5F, 0x64}: '焙',
+	{0x5F, 0x65}: '煥',
+	{0x5F, 0x66}: '煕',
+	{0x5F, 0x67}: '熈',
+	{0x5F, 0x68}: '煦',
+	{0x5F, 0x69}: '煢',
+	{0x5F, 0x6A}: '煌',
+	{0x5F, 0x6B}: '煖',
+	{0x5F, 0x6C}: '煬',
+	{0x5F, 0x6D}: '熏',
+	{0x5F, 0x6E}: '燻',
+	{0x5F, 0x6F}: '熄',
+	{0x5F, 0x70}: '熕',
+	{0x5F, 0x71}: '熨',
+	{0x5F, 0x72}: '熬',
+	{0x5F, 0x73}: '燗',
+	{0x5F, 0x74}: '熹',
+	{0x5F, 0x75}: '熾',
+	{0x5F, 0x76}: '燒',
+	{0x5F, 0x77}: '燉',
+	{0x5F, 0x78}: '燔',
+	{0x5F, 0x79}: '燎',
+	{0x5F, 0x7A}: '燠',
+	{0x5F, 0x7B}: '燬',
+	{0x5F, 0x7C}: '燧',
+	{0x5F, 0x7D}: '燵',
+	{0x5F, 0x7E}: '燼',
+	{0x60, 0x21}: '燹',
+	{0x60, 0x22}: '燿',
+	{0x60, 0x23}: '爍',
+	{0x60, 0x24}: '爐',
+	{0x60, 0x25}: '爛',
+	{0x60, 0x26}: '爨',
+	{0x60, 0x27}: '爭',
+	{0x60, 0x28}: '爬',
+	{0x60, 0x29}: '爰',
+	{0x60, 0x2A}: '爲',
+	{0x60, 0x2B}: '爻',
+	{0x60, 0x2C}: '爼',
+	{0x60, 0x2D}: '爿',
+	{0x60, 0x2E}: '牀',
+	{0x60, 0x2F}: '牆',
+	{0x60, 0x30}: '牋',
+	{0x60, 0x31}: '牘',
+	{0x60, 0x32}: '牴',
+	{0x60, 0x33}: '牾',
+	{0x60, 0x34}: '犂',
+	{0x60, 0x35}: '犁',
+	{0x60, 0x36}: '犇',
+	{0x60, 0x37}: '犒',
+	{0x60, 0x38}: '犖',
+	{0x60, 0x39}: '犢',
+	{0x60, 0x3A}: '犧',
+	{0x60, 0x3B}: '犹',
+	{0x60, 0x3C}: '犲',
+	{0x60, 0x3D}: '狃',
+	{0x60, 0x3E}: '狆',
+	{0x60, 0x3F}: '狄',
+	{0x60, 0x40}: '狎',
+	{0x60, 0x41}: '狒',
+	{0x60, 0x42}: '狢',
+	{0x60, 0x43}: '狠',
+	{0x60, 0x44}: '狡',
+	{0x60, 0x45}: '狹',
+	{0x60, 0x46}: '狷',
+	{0x60, 0x47}: '倏',
+	{0x60, 0x48}: '猗',
+	{0x60, 0x49}: '猊',
+	{0x60, 0x4A}: '猜',
+	{0x60, 0x4B}: '猖',
+	{0x60, 0x4C}: '猝',
+	{0x60, 0x4D}: '猴',
+	{0x60, 0x4E}: '猯',
+	{0x60, 0x4F}: '猩',
+	{0x60, 0x50}: '猥',
+	{0x60, 0x51}: '猾',
+	{0x60, 0x52}: '獎',
+	{0x60, 0x53}: '獏',
+	{0x60, 0x54}: '默',
+	{0x60, 0x55}: '獗',
+	{0x60, 0x56}: '獪',
+	{0x60, 0x57}: '獨',
+	{0x60, 0x58}: '獰',
+	{0x60, 0x59}: '獸',
+	{0x60, 0x5A}: '獵',
+	{0x60, 0x5B}: '獻',
+	{0x60, 0x5C}: '獺',
+	{0x60, 0x5D}: '珈',
+	{0x60, 0x5E}: '玳',
+	{0x60, 0x5F}: '珎',
+	{0x60, 0x60}: '玻',
+	{0x60, 0x61}: '珀',
+	{0x60, 0x62}: '珥',
+	{0x60, 0x63}: '珮',
+	{0x60, 0x64}: '珞',
+	{0x60, 0x65}: '璢',
+	{0x60, 0x66}: '琅',
+	{0x60, 0x67}: '瑯',
+	{0x60, 0x68}: '琥',
+	{0x60, 0x69}: '珸',
+	{0x60, 0x6A}: '琲',
+	{0x60, 0x6B}: '琺',
+	{0x60, 0x6C}: '瑕',
+	{0x60, 0x6D}: '琿',
+	{0x60, 0x6E}: '瑟',
+	{0x60, 0x6F}: '瑙',
+	{0x60, 0x70}: '瑁',
+	{0x60, 0x71}: '瑜',
+	{0x60, 0x72}: '瑩',
+	{0x60, 0x73}: '瑰',
+	{0x60, 0x74}: '瑣',
+	{0x60, 0x75}: '瑪',
+	{0x60, 0x76}: '瑶',
+	{0x60, 0x77}: '瑾',
+	{0x60, 0x78}: '璋',
+	{0x60, 0x79}: '璞',
+	{0x60, 0x7A}: '璧',
+	{0x60, 0x7B}: '瓊',
+	{0x60, 0x7C}: '瓏',
+	{0x60, 0x7D}: '瓔',
+	{0x60, 0x7E}: '珱',
+	{0x61, 0x21}: '瓠',
+	{0x61, 0x22}: '瓣',
+	{0x61, 0x23}: '瓧',
+	{0x61, 0x24}: '瓩',
+	{0x61, 0x25}: '瓮',
+	{0x61, 0x26}: '瓲',
+	{0x61, 0x27}: '瓰',
+	{0x61, 0x28}: '瓱',
+	{0x61, 0x29}: '瓸',
+	{0x61, 0x2A}: '瓷',
+	{0x61, 0x2B}: '甄',
+	{0x61, 0x2C}: '甃',
+	{0x61, 0x2D}: '甅',
+	{0x61, 0x2E}: '甌',
+	{0x61, 0x2F}: '甎',
+	{0x61, 0x30}: '甍',
+	{0x61, 0x31}: '甕',
+	{0x61, 0x32}: '甓',
+	{0x61, 0x33}: '甞',
+	{0x61, 0x34}: '甦',
+	{0x61, 0x35}: '甬',
+	{0x61, 0x36}: '甼',
+	{0x61, 0x37}: '畄',
+	{0x61, 0x38}: '畍',
+	{0x61, 0x39}: '畊',
+	{0x61, 0x3A}: '畉',
+	{0x61, 0x3B}: '畛',
+	{0x61, 0x3C}: '畆',
+	{0x61, 0x3D}: '畚',
+	{0x61, 0x3E}: '畩',
+	{0x61, 0x3F}: '畤',
+	{0x61, 0x40}: '畧',
+	{0x61, 0x41}: '畫',
+	{0x61, 0x42}: '畭',
+	{0x61, 0x43}: '畸',
+	{0x61, 0x44}: '當',
+	{0x61, 0x45}: '疆',
+	{0x61, 0x46}: '疇',
+	{0x61, 0x47}: '畴',
+	{0x61, 0x48}: '疊',
+	{0x61, 0x49}: '疉',
+	{0x61, 0x4A}: '疂',
+	{0x61, 0x4B}: '疔',
+	{0x61, 0x4C}: '疚',
+	{0x61, 0x4D}: '疝',
+	{0x61, 0x4E}: '疥',
+	{0x61, 0x4F}: '疣',
+	{0x61, 0x50}: '痂',
+	{0x61, 0x51}: '疳',
+	{0x61, 0x52}: '痃',
+	{0x61, 0x53}: '疵',
+	{0x61, 0x54}: '疽',
+	{0x61, 0x55}: '疸',
+	{0x61, 0x56}: '疼',
+	{0x61, 0x57}: '疱',
+	{0x61, 0x58}: '痍',
+	{0x61, 0x59}: '痊',
+	{0x61, 0x5A}: '痒',
+	{0x61, 0x5B}: '痙',
+	{0x61, 0x5C}: '痣',
+	{0x61, 0x5D}: '痞',
+	{0x61, 0x5E}: '痾',
+	{0x61, 0x5F}: '痿',
+	{0x61, 0x60}: '痼',
+	{0x61, 0x61}: '瘁',
+	{0x61, 0x62}: '痰',
+	{0x61, 0x63}: '痺',
+	{0x61, 0x64}: '痲',
+	{0x61, 0x65}: '痳',
+	{0x61, 0x66}: '瘋',
+	{0x61, 0x67}: '瘍',
+	{0x61, 0x68}: '瘉',
+	{0x61, 0x69}: '瘟',
+	{0x61, 0x6A}: '瘧',
+	{0x61, 0x6B}: '瘠',
+	{0x61, 0x6C}: '瘡',
+	{0x61, 0x6D}: '瘢',
+	{0x61, 0x6E}: '瘤',
+	{0x61, 0x6F}: '瘴',
+	{0x61, 0x70}: '瘰',
+	{0x61, 0x71}: '瘻',
+	{0x61, 0x72}: '癇',
+	{0x61, 0x73}: '癈',
+	{0x61, 0x74}: '癆',
+	{0x61, 0x75}: '癜',
+	{0x61, 0x76}: '癘',
+	{0x61, 0x77}: '癡',
+	{0x61, 0x78}: '癢',
+	{0x61, 0x79}: '癨',
+	{0x61, 0x7A}: '癩',
+	{0x61, 0x7B}: '癪',
+	{0x61, 0x7C}: '癧',
+	{0x61, 0x7D}: '癬',
+	{0x61, 0x7E}: '癰',
+	{0x62, 0x21}: '癲',
+	{0x62, 0x22}: '癶',
+	{0x62, 0x23}: '癸',
+	{0x62, 0x24}: '發',
+	{0x62, 0x25}: '皀',
+	{0x62, 0x26}: '皃',
+	{0x62, 0x27}: '皈',
+	{0x62, 0x28}: '皋',
+	{0x62, 0x29}: '皎',
+	{0x62, 0x2A}: '皖',
+	{0x62, 0x2B}: '皓',
+	{0x62, 0x2C}: '皙',
+	{0x62, 0x2D}: '皚',
+	{0x62, 0x2E}: '皰',
+	{0x62, 0x2F}: '皴',
+	{0x62, 0x30}: '皸',
+	{0x62, 0x31}: '皹',
+	{0x62, 0x32}: '皺',
+	{0x62, 0x33}: '盂',
+	{0x62, 0x34}: '盍',
+	{0x62, 0x35}: '盖',
+	{0x62, 0x36}: '盒',
+	{0x62, 0x37}: '盞',
+	{0x62, 0x38}: '盡',
+	{0x62, 0x39}: '盥',
+	{0x62, 0x3A}: '盧',
+	{0x62, 0x3B}: '盪',
+	{0x62, 0x3C}: '蘯',
+	{0x62, 0x3D}: '盻',
+	{0x62, 0x3E}: '眈',
+	{0x62, 0x3F}: '眇',
+	{0x62, 0x40}: '眄',
+	{0x62, 0x41}: '眩',
+	{0x62, 0x42}: '眤',
+	{0x62, 0x43}: '眞',
+	{0x62, 0x44}: '眥',
+	{0x62, 0x45}: '眦',
+	{0x62, 0x46}: '眛',
+	{0x62, 0x47}: '眷',
+	{0x62, 0x48}: '眸',
+	{0x62, 0x49}: '睇',
+	{0x62, 0x4A}: '睚',
+	{0x62, 0x4B}: '睨',
+	{0x62, 0x4C}: '睫',
+	{0x62, 0x4D}: '睛',
+	{0x62, 0x4E}: '睥',
+	{0x62, 0x4F}: '睿',
+	{0x62, 0x50}: '睾',
+	{0x62, 0x51}: '睹',
+	{0x62, 0x52}: '瞎',
+	{0x62, 0x53}: '瞋',
+	{0x62, 0x54}: '瞑',
+	{0x62, 0x55}: '瞠',
+	{0x62, 0x56}: '瞞',
+	{0x62, 0x57}: '瞰',
+	{0x62, 0x58}: '瞶',
+	{0x62, 0x59}: '瞹',
+	{0x62, 0x5A}: '瞿',
+	{0x62, 0x5B}: '瞼',
+	{0x62, 0x5C}: '瞽',
+	{0x62, 0x5D}: '瞻',
+	{0x62, 0x5E}: '矇',
+	{0x62, 0x5F}: '矍',
+	{0x62, 0x60}: '矗',
+	{0x62, 0x61}: '矚',
+	{0x62, 0x62}: '矜',
+	{0x62, 0x63}: '矣',
+	{0x62, 0x64}: '矮',
+	{0x62, 0x65}: '矼',
+	{0x62, 0x66}: '砌',
+	{0x62, 0x67}: '砒',
+	{0x62, 0x68}: '礦',
+	{0x62, 0x69}: '砠',
+	{0x62, 0x6A}: '礪',
+	{0x62, 0x6B}: '硅',
+	{0x62, 0x6C}: '碎',
+	{0x62, 0x6D}: '硴',
+	{0x62, 0x6E}: '碆',
+	{0x62, 0x6F}: '硼',
+	{0x62, 0x70}: '碚',
+	{0x62, 0x71}: '碌',
+	{0x62, 0x72}: '碣',
+	{0x62, 0x73}: '碵',
+	{0x62, 0x74}: '碪',
+	{0x62, 0x75}: '碯',
+	{0x62, 0x76}: '磑',
+	{0x62, 0x77}: '磆',
+	{0x62, 0x78}: '磋',
+	{0x62, 0x79}: '磔',
+	{0x62, 0x7A}: '碾',
+	{0x62, 0x7B}: '碼',
+	{0x62, 0x7C}: '磅',
+	{0x62, 0x7D}: '磊',
+	{0x62, 0x7E}: '磬',
+	{0x63, 0x21}: '磧',
+	{0x63, 0x22}: '磚',
+	{0x63, 0x23}: '磽',
+	{0x63, 0x24}: '磴',
+	{0x63, 0x25}: '礇',
+	{0x63, 0x26}: '礒',
+	{0x63, 0x27}: '礑',
+	{0x63, 0x28}: '礙',
+	{0x63, 0x29}: '礬',
+	{0x63, 0x2A}: '礫',
+	{0x63, 0x2B}: '祀',
+	{0x63, 0x2C}: '祠',
+	{0x63, 0x2D}: '祗',
+	{0x63, 0x2E}: '祟',
+	{0x63, 0x2F}: '祚',
+	{0x63, 0x30}: '祕',
+	{0x63, 0x31}: '祓',
+	{0x63, 0x32}: '祺',
+	{0x63, 0x33}: '祿',
+	{0x63, 0x34}: '禊',
+	{0x63, 0x35}: '禝',
+	{0x63, 0x36}: '禧',
+	{0x63, 0x37}: '齋',
+	{0x63, 0x38}: '禪',
+	{0x63, 0x39}: '禮',
+	{0x63, 0x3A}: '禳',
+	{0x63, 0x3B}: '禹',
+	{0x63, 0x3C}: '禺',
+	{0x63, 0x3D}: '秉',
+	{0x63, 0x3E}: '秕',
+	{0x63, 0x3F}: '秧',
+	{0x63, 0x40}: '秬',
+	{0x63, 0x41}: '秡',
+	{0x63, 0x42}: '秣',
+	{0x63, 0x43}: '稈',
+	{0x63, 0x44}: '稍',
+	{0x63, 0x45}: '稘',
+	{0x63, 0x46}: '稙',
+	{0x63, 0x47}: '稠',
+	{0x63, 0x48}: '稟',
+	{0x63, 0x49}: '禀',
+	{0x63, 0x4A}: '稱',
+	{0x63, 0x4B}: '稻',
+	{0x63, 0x4C}: '稾',
+	{0x63, 0x4D}: '稷',
+	{0x63, 0x4E}: '穃',
+	{0x63, 0x4F}: '穗',
+	{0x63, 0x50}: '穉',
+	{0x63, 0x51}: '穡',
+	{0x63, 0x52}: '穢',
+	{0x63, 0x53}: '穩',
+	{0x63, 0x54}: '龝',
+	{0x63, 0x55}: '穰',
+	{0x63, 0x56}: '穹',
+	{0x63, 0x57}: '穽',
+	{0x63, 0x58}: '窈',
+	{0x63, 0x59}: '窗',
+	{0x63, 0x5A}: '窕',
+	{0x63, 0x5B}: '窘',
+	{0x63, 0x5C}: '窖',
+	{0x63, 0x5D}: '窩',
+	{0x63, 0x5E}: '竈',
+	{0x63, 0x5F}: '窰',
+	{0x63, 0x60}: '窶',
+	{0x63, 0x61}: '竅',
+	{0x63, 0x62}: '竄',
+	{0x63, 0x63}: '窿',
+	{0x63, 0x64}: '邃',
+	{0x63, 0x65}: '竇',
+	{0x63, 0x66}: '竊',
+	{0x63, 0x67}: '竍',
+	{0x63, 0x68}: '竏',
+	{0x63, 0x69}: '竕',
+	{0x63, 0x6A}: '竓',
+	{0x63, 0x6B}: '站',
+	{0x63, 0x6C}: '竚',
+	{0x63, 0x6D}: '竝',
+	{0x63, 0x6E}: '竡',
+	{0x63, 0x6F}: '竢',
+	{0x63, 0x70}: '竦',
+	{0x63, 0x71}: '竭',
+	{0x63, 0x72}: '竰',
+	{0x63, 0x73}: '笂',
+	{0x63, 0x74}: '笏',
+	{0x63, 0x75}: '笊',
+	{0x63, 0x76}: '笆',
+	{0x63, 0x77}: '笳',
+	{0x63, 0x78}: '笘',
+	{0x63, 0x79}: '笙',
+	{0x63, 0x7A}: '笞',
+	{0x63, 0x7B}: '笵',
+	{0x63, 0x7C}: '笨',
+	{0x63, 0x7D}: '笶',
+	{0x63, 0x7E}: '筐',
+	{0x64, 0x21}: '筺',
+	{0x64, 0x22}: '笄',
+	{0x64, 0x23}: '筍',
+	{0x64, 0x24}: '笋',
+	{0x64, 0x25}: '筌',
+	{0x64, 0x26}: '筅',
+	{0x64, 0x27}: '筵',
+	{0x64, 0x28}: '筥',
+	{0x64, 0x29}: '筴',
+	{0x64, 0x2A}: '筧',
+	{0x64, 0x2B}: '筰',
+	{0x64, 0x2C}: '筱',
+	{0x64, 0x2D}: '筬',
+	{0x64, 0x2E}: '筮',
+	{0x64, 0x2F}: '箝',
+	{0x64, 0x30}: '箘',
+	{0x64, 0x31}: '箟',
+	{0x64, 0x32}: '箍',
+	{0x64, 0x33}: '箜',
+	{0x64, 0x34}: '箚',
+	{0x64, 0x35}: '箋',
+	{0x64, 0x36}: '箒',
+	{0x64, 0x37}: '箏',
+	{0x64, 0x38}: '筝',
+	{0x64, 0x39}: '箙',
+	{0x64, 0x3A}: '篋',
+	{0x64, 0x3B}: '篁',
+	{0x64, 0x3C}: '篌',
+	{0x64, 0x3D}: '篏',
+	{0x64, 0x3E}: '箴',
+	{0x64, 0x3F}: '篆',
+	{0x64, 0x40}: '篝',
+	{0x64, 0x41}: '篩',
+	{0x64, 0x42}: '簑',
+	{0x64, 0x43}: '簔',
+	{0x64, 0x44}: '篦',
+	{0x64, 0x45}: '篥',
+	{0x64, 0x46}: '籠',
+	{0x64, 0x47}: '簀',
+	{0x64, 0x48}: '簇',
+	{0x64, 0x49}: '簓',
+	{0x64, 0x4A}: '篳',
+	{0x64, 0x4B}: '篷',
+	{0x64, 0x4C}: '簗',
+	{0x64, 0x4D}: '簍',
+	{0x64, 0x4E}: '篶',
+	{0x64, 0x4F}: '簣',
+	{0x64, 0x50}: '簧',
+	{0x64, 0x51}: '簪',
+	{0x64, 0x52}: '簟',
+	{0x64, 0x53}: '簷',
+	{0x64, 0x54}: '簫',
+	{0x64, 0x55}: '簽',
+	{0x64, 0x56}: '籌',
+	{0x64, 0x57}: '籃',
+	{0x64, 0x58}: '籔',
+	{0x64, 0x59}: '籏',
+	{0x64, 0x5A}: '籀',
+	{0x64, 0x5B}: '籐',
+	{0x64, 0x5C}: '籘',
+	{0x64, 0x5D}: '籟',
+	{0x64, 0x5E}: '籤',
+	{0x64, 0x5F}: '籖',
+	{0x64, 0x60}: '籥',
+	{0x64, 0x61}: '籬',
+	{0x64, 0x62}: '籵',
+	{0x64, 0x63}: '粃',
+	{0x64, 0x64}: '粐',
+	{0x64, 0x65}: '粤',
+	{0x64, 0x66}: '粭',
+	{0x64, 0x67}: '粢',
+	{0x64, 0x68}: '粫',
+	{0x64, 0x69}: '粡',
+	{0x64, 0x6A}: '粨',
+	{0x64, 0x6B}: '粳',
+	{0x64, 0x6C}: '粲',
+	{0x64, 0x6D}: '粱',
+	{0x64, 0x6E}: '粮',
+	{0x64, 0x6F}: '粹',
+	{0x64, 0x70}: '粽',
+	{0x64, 0x71}: '糀',
+	{0x64, 0x72}: '糅',
+	{0x64, 0x73}: '糂',
+	{0x64, 0x74}: '糘',
+	{0x64, 0x75}: '糒',
+	{0x64, 0x76}: '糜',
+	{0x64, 0x77}: '糢',
+	{0x64, 0x78}: '鬻',
+	{0x64, 0x79}: '糯',
+	{0x64, 0x7A}: '糲',
+	{0x64, 0x7B}: '糴',
+	{0x64, 0x7C}: '糶',
+	{0x64, 0x7D}: '糺',
+	{0x64, 0x7E}: '紆',
+	{0x65, 0x21}: '紂',
+	{0x65, 0x22}: '紜',
+	{0x65, 0x23}: '紕',
+	{0x65, 0x24}: '紊',
+	{0x65, 0x25}: '絅',
+	{0x65, 0x26}: '絋',
+	{0x65, 0x27}: '紮',
+	{0x65, 0x28}: '紲',
+	{0x65, 0x29}: '紿',
+	{0x65, 0x2A}: '紵',
+	{0x65, 0x2B}: '絆',
+	{0x65, 0x2C}: '絳',
+	{0x65, 0x2D}: '絖',
+	{0x65, 0x2E}: '絎',
+	{0x65, 0x2F}: '絲',
+	{0x65, 0x30}: '絨',
+	{0x65, 0x31}: '絮',
+	{0x65, 0x32}: '絏',
+	{0x65, 0x33}: '絣',
+	{0x65, 0x34}: '經',
+	{0x65, 0x35}: '綉',
+	{0x65, 0x36}: '絛',
+	{0x65, 0x37}: '綏',
+	{0x65, 0x38}: '絽',
+	{0x65, 0x39}: '綛',
+	{0x65, 0x3A}: '綺',
+	{0x65, 0x3B}: '綮',
+	{0x65, 0x3C}: '綣',
+	{0x65, 0x3D}: '綵',
+	{0x65, 0x3E}: '緇',
+	{0x65, 0x3F}: '綽',
+	{0x65, 0x40}: '綫',
+	{0x65, 0x41}: '總',
+	{0x65, 0x42}: '綢',
+	{0x65, 0x43}: '綯',
+	{0x65, 0x44}: '緜',
+	{0x65, 0x45}: '綸',
+	{0x65, 0x46}: '綟',
+	{0x65, 0x47}: '綰',
+	{0x65, 0x48}: '緘',
+	{0x65, 0x49}: '緝',
+	{0x65, 0x4A}: '緤',
+	{0x65, 0x4B}: '緞',
+	{0x65, 0x4C}: '緻',
+	{0x65, 0x4D}: '緲',
+	{0x65, 0x4E}: '緡',
+	{0x65, 0x4F}: '縅',
+	{0x65, 0x50}: '縊',
+	{0x65, 0x51}: '縣',
+	{0x65, 0x52}: '縡',
+	{0x65, 0x53}: '縒',
+	{0x65, 0x54}: '縱',
+	{0x65, 0x55}: '縟',
+	{0x65, 0x56}: '縉',
+	{0x65, 0x57}: '縋',
+	{0x65, 0x58}: '縢',
+	{0x65, 0x59}: '繆',
+	{0x65, 0x5A}: '繦',
+	{0x65, 0x5B}: '縻',
+	{0x65, 0x5C}: '縵',
+	{0x65, 0x5D}: '縹',
+	{0x65, 0x5E}: '繃',
+	{0x65, 0x5F}: '縷',
+	{0x65, 0x60}: '縲',
+	{0x65, 0x61}: '縺',
+	{0x65, 0x62}: '繧',
+	{0x65, 0x63}: '繝',
+	{0x65, 0x64}: '繖',
+	{0x65, 0x65}: '繞',
+	{0x65, 0x66}: '繙',
+	{0x65, 0x67}: '繚',
+	{0x65, 0x68}: '繹',
+	{0x65, 0x69}: '繪',
+	{0x65, 0x6A}: '繩',
+	{0x65, 0x6B}: '繼',
+	{0x65, 0x6C}: '繻',
+	{0x65, 0x6D}: '纃',
+	{0x65, 0x6E}: '緕',
+	{0x65, 0x6F}: '繽',
+	{0x65, 0x70}: '辮',
+	{0x65, 0x71}: '繿',
+	{0x65, 0x72}: '纈',
+	{0x65, 0x73}: '纉',
+	{0x65, 0x74}: '續',
+	{0x65, 0x75}: '纒',
+	{0x65, 0x76}: '纐',
+	{0x65, 0x77}: '纓',
+	{0x65, 0x78}: '纔',
+	{0x65, 0x79}: '纖',
+	{0x65, 0x7A}: '纎',
+	{0x65, 0x7B}: '纛',
+	{0x65, 0x7C}: '纜',
+	{0x65, 0x7D}: '缸',
+	{0x65, 0x7E}: '缺',
+	{0x66, 0x21}: '罅',
+	{0x66, 0x22}: '罌',
+	{0x66, 0x23}: '罍',
+	{0x66, 0x24}: '罎',
+	{0x66, 0x25}: '罐',
+	{0x66, 0x26}: '网',
+	{0x66, 0x27}: '罕',
+	{0x66, 0x28}: '罔',
+	{0x66, 0x29}: '罘',
+	{0x66, 0x2A}: '罟',
+	{0x66, 0x2B}: '罠',
+	{0x66, 0x2C}: '罨',
+	{0x66, 0x2D}: '罩',
+	{0x66, 0x2E}: '罧',
+	{0x66, 0x2F}: '罸',
+	{0x66, 0x30}: '羂',
+	{0x66, 0x31}: '羆',
+	{0x66, 0x32}: '羃',
+	{0x66, 0x33}: '羈',
+	{0x66, 0x34}: '羇',
+	{0x66, 0x35}: '羌',
+	{0x66, 0x36}: '羔',
+	{0x66, 0x37}: '羞',
+	{0x66, 0x38}: '羝',
+	{0x66, 0x39}: '羚',
+	{0x66, 0x3A}: '羣',
+	{0x66, 0x3B}: '羯',
+	{0x66, 0x3C}: '羲',
+	{0x66, 0x3D}: '羹',
+	{0x66, 0x3E}: '羮',
+	{0x66, 0x3F}: '羶',
+	{0x66, 0x40}: '羸',
+	{0x66, 0x41}: '譱',
+	{0x66, 0x42}: '翅',
+	{0x66, 0x43}: '翆',
+	{0x66, 0x44}: '翊',
+	{0x66, 0x45}: '翕',
+	{0x66, 0x46}: '翔',
+	{0x66, 0x47}: '翡',
+	{0x66, 0x48}: '翦',
+	{0x66, 0x49}: '翩',
+	{0x66, 0x4A}: '翳',
+	{0x66, 0x4B}: '翹',
+	{0x66, 0x4C}: '飜',
+	{0x66, 0x4D}: '耆',
+	{0x66, 0x4E}: '耄',
+	{0x66, 0x4F}: '耋',
+	{0x66, 0x50}: '耒',
+	{0x66, 0x51}: '耘',
+	{0x66, 0x52}: '耙',
+	{0x66, 0x53}: '耜',
+	{0x66, 0x54}: '耡',
+	{0x66, 0x55}: '耨',
+	{0x66, 0x56}: '耿',
+	{0x66, 0x57}: '耻',
+	{0x66, 0x58}: '聊',
+	{0x66, 0x59}: '聆',
+	{0x66, 0x5A}: '聒',
+	{0x66, 0x5B}: '聘',
+	{0x66, 0x5C}: '聚',
+	{0x66, 0x5D}: '聟',
+	{0x66, 0x5E}: '聢',
+	{0x66, 0x5F}: '聨',
+	{0x66, 0x60}: '聳',
+	{0x66, 0x61}: '聲',
+	{0x66, 0x62}: '聰',
+	{0x66, 0x63}: '聶',
+	{0x66, 0x64}: '聹',
+	{0x66, 0x65}: '聽',
+	{0x66, 0x66}: '聿',
+	{0x66, 0x67}: '肄',
+	{0x66, 0x68}: '肆',
+	{0x66, 0x69}: '肅',
+	{0x66, 0x6A}: '肛',
+	{0x66, 0x6B}: '肓',
+	{0x66, 0x6C}: '肚',
+	{0x66, 0x6D}: '肭',
+	{0x66, 0x6E}: '冐',
+	{0x66, 0x6F}: '肬',
+	{0x66, 0x70}: '胛',
+	{0x66, 0x71}: '胥',
+	{0x66, 0x72}: '胙',
+	{0x66, 0x73}: '胝',
+	{0x66, 0x74}: '胄',
+	{0x66, 0x75}: '胚',
+	{0x66, 0x76}: '胖',
+	{0x66, 0x77}: '脉',
+	{0x66, 0x78}: '胯',
+	{0x66, 0x79}: '胱',
+	{0x66, 0x7A}: '脛',
+	{0x66, 0x7B}: '脩',
+	{0x66, 0x7C}: '脣',
+	{0x66, 0x7D}: '脯',
+	{0x66, 0x7E}: '腋',
+	{0x67, 0x21}: '隋',
+	{0x67, 0x22}: '腆',
+	{0x67, 0x23}: '脾',
+	{0x67, 0x24}: '腓',
+	{0x67, 0x25}: '腑',
+	{0x67, 0x26}: '胼',
+	{0x67, 0x27}: '腱',
+	{0x67, 0x28}: '腮',
+	{0x67, 0x29}: '腥',
+	{0x67, 0x2A}: '腦',
+	{0x67, 0x2B}: '腴',
+	{0x67, 0x2C}: '膃',
+	{0x67, 0x2D}: '膈',
+	{0x67, 0x2E}: '膊',
+	{0x67, 0x2F}: '膀',
+	{0x67, 0x30}: '膂',
+	{0x67, 0x31}: '膠',
+	{0x67, 0x32}: '膕',
+	{0x67, 0x33}: '膤',
+	{0x67, 0x34}: '膣',
+	{0x67, 0x35}: '腟',
+	{0x67, 0x36}: '膓',
+	{0x67, 0x37}: '膩',
+	{0x67, 0x38}: '膰',
+	{0x67, 0x39}: '膵',
+	{0x67, 0x3A}: '膾',
+	{0x67, 0x3B}: '膸',
+	{0x67, 0x3C}: '膽',
+	{0x67, 0x3D}: '臀',
+	{0x67, 0x3E}: '臂',
+	{0x67, 0x3F}: '膺',
+	{0x67, 0x40}: '臉',
+	{0x67, 0x41}: '臍',
+	{0x67, 0x42}: '臑',
+	{0x67, 0x43}: '臙',
+	{0x67, 0x44}: '臘',
+	{0x67, 0x45}: '臈',
+	{0x67, 0x46}: '臚',
+	{0x67, 0x47}: '臟',
+	{0x67, 0x48}: '臠',
+	{0x67, 0x49}: '臧',
+	{0x67, 0x4A}: '臺',
+	{0x67, 0x4B}: '臻',
+	{0x67, 0x4C}: '臾',
+	{0x67, 0x4D}: '舁',
+	{0x67, 0x4E}: '舂',
+	{0x67, 0x4F}: '舅',
+	{0x67, 0x50}: '與',
+	{0x67, 0x51}: '舊',
+	{0x67, 0x52}: '舍',
+	{0x67, 0x53}: '舐',
+	{0x67, 0x54}: '舖',
+	{0x67, 0x55}: '舩',
+	{0x67, 0x56}: '舫',
+	{0x67, 0x57}: '舸',
+	{0x67, 0x58}: '舳',
+	{0x67, 0x59}: '艀',
+	{0x67, 0x5A}: '艙',
+	{0x67, 0x5B}: '艘',
+	{0x67, 0x5C}: '艝',
+	{0x67, 0x5D}: '艚',
+	{0x67, 0x5E}: '艟',
+	{0x67, 0x5F}: '艤',
+	{0x67, 0x60}: '艢',
+	{0x67, 0x61}: '艨',
+	{0x67, 0x62}: '艪',
+	{0x67, 0x63}: '艫',
+	{0x67, 0x64}: '舮',
+	{0x67, 0x65}: '艱',
+	{0x67, 0x66}: '艷',
+	{0x67, 0x67}: '艸',
+	{0x67, 0x68}: '艾',
+	{0x67, 0x69}: '芍',
+	{0x67, 0x6A}: '芒',
+	{0x67, 0x6B}: '芫',
+	{0x67, 0x6C}: '芟',
+	{0x67, 0x6D}: '芻',
+	{0x67, 0x6E}: '芬',
+	{0x67, 0x6F}: '苡',
+	{0x67, 0x70}: '苣',
+	{0x67, 0x71}: '苟',
+	{0x67, 0x72}: '苒',
+	{0x67, 0x73}: '苴',
+	{0x67, 0x74}: '苳',
+	{0x67, 0x75}: '苺',
+	{0x67, 0x76}: '莓',
+	{0x67, 0x77}: '范',
+	{0x67, 0x78}: '苻',
+	{0x67, 0x79}: '苹',
+	{0x67, 0x7A}: '苞',
+	{0x67, 0x7B}: '茆',
+	{0x67, 0x7C}: '苜',
+	{0x67, 0x7D}: '茉',
+	{0x67, 0x7E}: '苙',
+	{0x68, 0x21}: '茵',
+	{0x68, 0x22}: '茴',
+	{0x68, 0x23}: '茖',
+	{0x68, 0x24}: '茲',
+	{0x68, 0x25}: '茱',
+	{0x68, 0x26}: '荀',
+	{0x68, 0x27}: '茹',
+	{0x68, 0x28}: '荐',
+	{0x68, 0x29}: '荅',
+	{0x68, 0x2A}: '茯',
+	{0x68, 0x2B}: '茫',
+	{0x68, 0x2C}: '茗',
+	{0x68, 0x2D}: '茘',
+	{0x68, 0x2E}: '莅',
+	{0x68, 0x2F}: '莚',
+	{0x68, 0x30}: '莪',
+	{0x68, 0x31}: '莟',
+	{0x68, 0x32}: '莢',
+	{0x68, 0x33}: '莖',
+	{0x68, 0x34}: '茣',
+	{0x68, 0x35}: '莎',
+	{0x68, 0x36}: '莇',
+	{0x68, 0x37}: '莊',
+	{0x68, 0x38}: '荼',
+	{0x68, 0x39}: '莵',
+	{0x68, 0x3A}: '荳',
+	{0x68, 0x3B}: '荵',
+	{0x68, 0x3C}: '莠',
+	{0x68, 0x3D}: '莉',
+	{0x68, 0x3E}: '莨',
+	{0x68, 0x3F}: '菴',
+	{0x68, 0x40}: '萓',
+	{0x68, 0x41}: '菫',
+	{0x68, 0x42}: '菎',
+	{0x68, 0x43}: '菽',
+	{0x68, 0x44}: '萃',
+	{0x68, 0x45}: '菘',
+	{0x68, 0x46}: '萋',
+	{0x68, 0x47}: '菁',
+	{0x68, 0x48}: '菷',
+	{0x68, 0x49}: '萇',
+	{0x68, 0x4A}: '菠',
+	{0x68, 0x4B}: '菲',
+	{0x68, 0x4C}: '萍',
+	{0x68, 0x4D}: '萢',
+	{0x68, 0x4E}: '萠',
+	{0x68, 0x4F}: '莽',
+	{0x68, 0x50}: '萸',
+	{0x68, 0x51}: '蔆',
+	{0x68, 0x52}: '菻',
+	{0x68, 0x53}: '葭',
+	{0x68, 0x54}: '萪',
+	{0x68, 0x55}: '萼',
+	{0x68, 0x56}: '蕚',
+	{0x68, 0x57}: '蒄',
+	{0x68, 0x58}: '葷',
+	{0x68, 0x59}: '葫',
+	{0x68, 0x5A}: '蒭',
+	{0x68, 0x5B}: '葮',
+	{0x68, 0x5C}: '蒂',
+	{0x68, 0x5D}: '葩',
+	{0x68, 0x5E}: '葆',
+	{0x68, 0x5F}: '萬',
+	{0x68, 0x60}: '葯',
+	{0x68, 0x61}: '葹',
+	{0x68, 0x62}: '萵',
+	{0x68, 0x63}: '蓊',
+	{0x68, 0x64}: '葢',
+	{0x68, 0x65}: '蒹',
+	{0x68, 0x66}: '蒿',
+	{0x68, 0x67}: '蒟',
+	{0x68, 0x68}: '蓙',
+	{0x68, 0x69}: '蓍',
+	{0x68, 0x6A}: '蒻',
+	{0x68, 0x6B}: '蓚',
+	{0x68, 0x6C}: '蓐',
+	{0x68, 0x6D}: '蓁',
+	{0x68, 0x6E}: '蓆',
+	{0x68, 0x6F}: '蓖',
+	{0x68, 0x70}: '蒡',
+	{0x68, 0x71}: '蔡',
+	{0x68, 0x72}: '蓿',
+	{0x68, 0x73}: '蓴',
+	{0x68, 0x74}: '蔗',
+	{0x68, 0x75}: '蔘',
+	{0x68, 0x76}: '蔬',
+	{0x68, 0x77}: '蔟',
+	{0x68, 0x78}: '蔕',
+	{0x68, 0x79}: '蔔',
+	{0x68, 0x7A}: '蓼',
+	{0x68, 0x7B}: '蕀',
+	{0x68, 0x7C}: '蕣',
+	{0x68, 0x7D}: '蕘',
+	{0x68, 0x7E}: '蕈',
+	{0x69, 0x21}: '蕁',
+	{0x69, 0x22}: '蘂',
+	{0x69, 0x23}: '蕋',
+	{0x69, 0x24}: '蕕',
+	{0x69, 0x25}: '薀',
+	{0x69, 0x26}: '薤',
+	{0x69, 0x27}: '薈',
+	{0x69, 0x28}: '薑',
+	{0x69, 0x29}: '薊',
+	{0x69, 0x2A}: '薨',
+	{0x69, 0x2B}: '蕭',
+	{0x69, 0x2C}: '薔',
+	{0x69, 0x2D}: '薛',
+	{0x69, 0x2E}: '藪',
+	{0x69, 0x2F}: '薇',
+	{0x69, 0x30}: '薜',
+	{0x69, 0x31}: '蕷',
+	{0x69, 0x32}: '蕾',
+	{0x69, 0x33}: '薐',
+	{0x69, 0x34}: '藉',
+	{0x69, 0x35}: '薺',
+	{0x69, 0x36}: '藏',
+	{0x69, 0x37}: '薹',
+	{0x69, 0x38}: '藐',
+	{0x69, 0x39}: '藕',
+	{0x69, 0x3A}: '藝',
+	{0x69, 0x3B}: '藥',
+	{0x69, 0x3C}: '藜',
+	{0x69, 0x3D}: '藹',
+	{0x69, 0x3E}: '蘊',
+	{0x69, 0x3F}: '蘓',
+	{0x69, 0x40}: '蘋',
+	{0x69, 0x41}: '藾',
+	{0x69, 0x42}: '藺',
+	{0x69, 0x43}: '蘆',
+	{0x69, 0x44}: '蘢',
+	{0x69, 0x45}: '蘚',
+	{0x69, 0x46}: '蘰',
+	{0x69, 0x47}: '蘿',
+	{0x69, 0x48}: '虍',
+	{0x69, 0x49}: '乕',
+	{0x69, 0x4A}: '虔',
+	{0x69, 0x4B}: '號',
+	{0x69, 0x4C}: '虧',
+	{0x69, 0x4D}: '虱',
+	{0x69, 0x4E}: '蚓',
+	{0x69, 0x4F}: '蚣',
+	{0x69, 0x50}: '蚩',
+	{0x69, 0x51}: '蚪',
+	{0x69, 0x52}: '蚋',
+	{0x69, 0x53}: '蚌',
+	{0x69, 0x54}: '蚶',
+	{0x69, 0x55}: '蚯',
+	{0x69, 0x56}: '蛄',
+	{0x69, 0x57}: '蛆',
+	{0x69, 0x58}: '蚰',
+	{0x69, 0x59}: '蛉',
+	{0x69, 0x5A}: '蠣',
+	{0x69, 0x5B}: '蚫',
+	{0x69, 0x5C}: '蛔',
+	{0x69, 0x5D}: '蛞',
+	{0x69, 0x5E}: '蛩',
+	{0x69, 0x5F}: '蛬',
+	{0x69, 0x60}: '蛟',
+	{0x69, 0x61}: '蛛',
+	{0x69, 0x62}: '蛯',
+	{0x69, 0x63}: '蜒',
+	{0x69, 0x64}: '蜆',
+	{0x69, 0x65}: '蜈',
+	{0x69, 0x66}: '蜀',
+	{0x69, 0x67}: '蜃',
+	{0x69, 0x68}: '蛻',
+	{0x69, 0x69}: '蜑',
+	{0x69, 0x6A}: '蜉',
+	{0x69, 0x6B}: '蜍',
+	{0x69, 0x6C}: '蛹',
+	{0x69, 0x6D}: '蜊',
+	{0x69, 0x6E}: '蜴',
+	{0x69, 0x6F}: '蜿',
+	{0x69, 0x70}: '蜷',
+	{0x69, 0x71}: '蜻',
+	{0x69, 0x72}: '蜥',
+	{0x69, 0x73}: '蜩',
+	{0x69, 0x74}: '蜚',
+	{0x69, 0x75}: '蝠',
+	{0x69, 0x76}: '蝟',
+	{0x69, 0x77}: '蝸',
+	{0x69, 0x78}: '蝌',
+	{0x69, 0x79}: '蝎',
+	{0x69, 0x7A}: '蝴',
+	{0x69, 0x7B}: '蝗',
+	{0x69, 0x7C}: '蝨',
+	{0x69, 0x7D}: '蝮',
+	{0x69, 0x7E}: '蝙',
+	{0x6A, 0x21}: '蝓',
+	{0x6A, 0x22}: '蝣',
+	{0x6A, 0x23}: '蝪',
+	{0x6A, 0x24}: '蠅',
+	{0x6A, 0x25}: '螢',
+	{0x6A, 0x26}: '螟',
+	{0x6A, 0x27}: '螂',
+	{0x6A, 0x28}: '螯',
+	{0x6A, 0x29}: '蟋',
+	{0x6A, 0x2A}: '螽',
+	{0x6A, 0x2B}: '蟀',
+	{0x6A, 0x2C}: '蟐',
+	{0x6A, 0x2D}: '雖',
+	{0x6A, 0x2E}: '螫',
+	{0x6A, 0x2F}: '蟄',
+	{0x6A, 0x30}: '螳',
+	{0x6A, 0x31}: '蟇',
+	{0x6A, 0x32}: '蟆',
+	{0x6A, 0x33}: '螻',
+	{0x6A, 0x34}: '蟯',
+	{0x6A, 0x35}: '蟲',
+	{0x6A, 0x36}: '蟠',
+	{0x6A, 0x37}: '蠏',
+	{0x6A, 0x38}: '蠍',
+	{0x6A, 0x39}: '蟾',
+	{0x6A, 0x3A}: '蟶',
+	{0x6A, 0x3B}: '蟷',
+	{0x6A, 0x3C}: '蠎',
+	{0x6A, 0x3D}: '蟒',
+	{0x6A, 0x3E}: '蠑',
+	{0x6A, 0x3F}: '蠖',
+	{0x6A, 0x40}: '蠕',
+	{0x6A, 0x41}: '蠢',
+	{0x6A, 0x42}: '蠡',
+	{0x6A, 0x43}: '蠱',
+	{0x6A, 0x44}: '蠶',
+	{0x6A, 0x45}: '蠹',
+	{0x6A, 0x46}: '蠧',
+	{0x6A, 0x47}: '蠻',
+	{0x6A, 0x48}: '衄',
+	{0x6A, 0x49}: '衂',
+	{0x6A, 0x4A}: '衒',
+	{0x6A, 0x4B}: '衙',
+	{0x6A, 0x4C}: '衞',
+	{0x6A, 0x4D}: '衢',
+	{0x6A, 0x4E}: '衫',
+	{0x6A, 0x4F}: '袁',
+	{0x6A, 0x50}: '衾',
+	{0x6A, 0x51}: '袞',
+	{0x6A, 0x52}: '衵',
+	{0x6A, 0x53}: '衽',
+	{0x6A, 0x54}: '袵',
+	{0x6A, 0x55}: '衲',
+	{0x6A, 0x56}: '袂',
+	{0x6A, 0x57}: '袗',
+	{0x6A, 0x58}: '袒',
+	{0x6A, 0x59}: '袮',
+	{0x6A, 0x5A}: '袙',
+	{0x6A, 0x5B}: '袢',
+	{0x6A, 0x5C}: '袍',
+	{0x6A, 0x5D}: '袤',
+	{0x6A, 0x5E}: '袰',
+	{0x6A, 0x5F}: '袿',
+	{0x6A, 0x60}: '袱',
+	{0x6A, 0x61}: '裃',
+	{0x6A, 0x62}: '裄',
+	{0x6A, 0x63}: '裔',
+	{0x6A, 0x64}: '裘',
+	{0x6A, 0x65}: '裙',
+	{0x6A, 0x66}: '裝',
+	{0x6A, 0x67}: '裹',
+	{0x6A, 0x68}: '褂',
+	{0x6A, 0x69}: '裼',
+	{0x6A, 0x6A}: '裴',
+	{0x6A, 0x6B}: '裨',
+	{0x6A, 0x6C}: '裲',
+	{0x6A, 0x6D}: '褄',
+	{0x6A, 0x6E}: '褌',
+	{0x6A, 0x6F}: '褊',
+	{0x6A, 0x70}: '褓',
+	{0x6A, 0x71}: '襃',
+	{0x6A, 0x72}: '褞',
+	{0x6A, 0x73}: '褥',
+	{0x6A, 0x74}: '褪',
+	{0x6A, 0x75}: '褫',
+	{0x6A, 0x76}: '襁',
+	{0x6A, 0x77}: '襄',
+	{0x6A, 0x78}: '褻',
+	{0x6A, 0x79}: '褶',
+	{0x6A, 0x7A}: '褸',
+	{0x6A, 0x7B}: '襌',
+	{0x6A, 0x7C}: '褝',
+	{0x6A, 0x7D}: '襠',
+	{0x6A, 0x7E}: '襞',
+	{0x6B, 0x21}: '襦',
+	{0x6B, 0x22}: '襤',
+	{0x6B, 0x23}: '襭',
+	{0x6B, 0x24}: '襪',
+	{0x6B, 0x25}: '襯',
+	{0x6B, 0x26}: '襴',
+	{0x6B, 0x27}: '襷',
+	{0x6B, 0x28}: '襾',
+	{0x6B, 0x29}: '覃',
+	{0x6B, 0x2A}: '覈',
+	{0x6B, 0x2B}: '覊',
+	{0x6B, 0x2C}: '覓',
+	{0x6B, 0x2D}: '覘',
+	{0x6B, 0x2E}: '覡',
+	{0x6B, 0x2F}: '覩',
+	{0x6B, 0x30}: '覦',
+	{0x6B, 0x31}: '覬',
+	{0x6B, 0x32}: '覯',
+	{0x6B, 0x33}: '覲',
+	{0x6B, 0x34}: '覺',
+	{0x6B, 0x35}: '覽',
+	{0x6B, 0x36}: '覿',
+	{0x6B, 0x37}: '觀',
+	{0x6B, 0x38}: '觚',
+	{0x6B, 0x39}: '觜',
+	{0x6B, 0x3A}: '觝',
+	{0x6B, 0x3B}: '觧',
+	{0x6B, 0x3C}: '觴',
+	{0x6B, 0x3D}: '觸',
+	{0x6B, 0x3E}: '訃',
+	{0x6B, 0x3F}: '訖',
+	{0x6B, 0x40}: '訐',
+	{0x6B, 0x41}: '訌',
+	{0x6B, 0x42}: '訛',
+	{0x6B, 0x43}: '訝',
+	{0x6B, 0x44}: '訥',
+	{0x6B, 0x45}: '訶',
+	{0x6B, 0x46}: '詁',
+	{0x6B, 0x47}: '詛',
+	{0x6B, 0x48}: '詒',
+	{0x6B, 0x49}: '詆',
+	{0x6B, 0x4A}: '詈',
+	{0x6B, 0x4B}: '詼',
+	{0x6B, 0x4C}: '詭',
+	{0x6B, 0x4D}: '詬',
+	{0x6B, 0x4E}: '詢',
+	{0x6B, 0x4F}: '誅',
+	{0x6B, 0x50}: '誂',
+	{0x6B, 0x51}: '誄',
+	{0x6B, 0x52}: '誨',
+	{0x6B, 0x53}: '誡',
+	{0x6B, 0x54}: '誑',
+	{0x6B, 0x55}: '誥',
+	{0x6B, 0x56}: '誦',
+	{0x6B, 0x57}: '誚',
+	{0x6B, 0x58}: '誣',
+	{0x6B, 0x59}: '諄',
+	{0x6B, 0x5A}: '諍',
+	{0x6B, 0x5B}: '諂',
+	{0x6B, 0x5C}: '諚',
+	{0x6B, 0x5D}: '諫',
+	{0x6B, 0x5E}: '諳',
+	{0x6B, 0x5F}: '諧',
+	{0x6B, 0x60}: '諤',
+	{0x6B, 0x61}: '諱',
+	{0x6B, 0x62}: '謔',
+	{0x6B, 0x63}: '諠',
+	{0x6B, 0x64}: '諢',
+	{0x6B, 0x65}: '諷',
+	{0x6B, 0x66}: '諞',
+	{0x6B, 0x67}: '諛',
+	{0x6B, 0x68}: '謌',
+	{0x6B, 0x69}: '謇',
+	{0x6B, 0x6A}: '謚',
+	{0x6B, 0x6B}: '諡',
+	{0x6B, 0x6C}: '謖',
+	{0x6B, 0x6D}: '謐',
+	{0x6B, 0x6E}: '謗',
+	{0x6B, 0x6F}: '謠',
+	{0x6B, 0x70}: '謳',
+	{0x6B, 0x71}: '鞫',
+	{0x6B, 0x72}: '謦',
+	{0x6B, 0x73}: '謫',
+	{0x6B, 0x74}: '謾',
+	{0x6B, 0x75}: '謨',
+	{0x6B, 0x76}: '譁',
+	{0x6B, 0x77}: '譌',
+	{0x6B, 0x78}: '譏',
+	{0x6B, 0x79}: '譎',
+	{0x6B, 0x7A}: '證',
+	{0x6B, 0x7B}: '譖',
+	{0x6B, 0x7C}: '譛',
+	{0x6B, 0x7D}: '譚',
+	{0x6B, 0x7E}: '譫',
+	{0x6C, 0x21}: '譟',
+	{0x6C, 0x22}: '譬',
+	{0x6C, 0x23}: '譯',
+	{0x6C, 0x24}: '譴',
+	{0x6C, 0x25}: '譽',
+	{0x6C, 0x26}: '讀',
+	{0x6C, 0x27}: '讌',
+	{0x6C, 0x28}: '讎',
+	{0x6C, 0x29}: '讒',
+	{0x6C, 0x2A}: '讓',
+	{0x6C, 0x2B}: '讖',
+	{0x6C, 0x2C}: '讙',
+	{0x6C, 0x2D}: '讚',
+	{0x6C, 0x2E}: '谺',
+	{0x6C, 0x2F}: '豁',
+	{0x6C, 0x30}: '谿',
+	{0x6C, 0x31}: '豈',
+	{0x6C, 0x32}: '豌',
+	{0x6C, 0x33}: '豎',
+	{0x6C, 0x34}: '豐',
+	{0x6C, 0x35}: '豕',
+	{0x6C, 0x36}: '豢',
+	{0x6C, 0x37}: '豬',
+	{0x6C, 0x38}: '豸',
+	{0x6C, 0x39}: '豺',
+	{0x6C, 0x3A}: '貂',
+	{0x6C, 0x3B}: '貉',
+	{0x6C, 0x3C}: '貅',
+	{0x6C, 0x3D}: '貊',
+	{0x6C, 0x3E}: '貍',
+	{0x6C, 0x3F}: '貎',
+	{0x6C, 0x40}: '貔',
+	{0x6C, 0x41}: '豼',
+	{0x6C, 0x42}: '貘',
+	{0x6C, 0x43}: '戝',
+	{0x6C, 0x44}: '貭',
+	{0x6C, 0x45}: '貪',
+	{0x6C, 0x46}: '貽',
+	{0x6C, 0x47}: '貲',
+	{0x6C, 0x48}: '貳',
+	{0x6C, 0x49}: '貮',
+	{0x6C, 0x4A}: '貶',
+	{0x6C, 0x4B}: '賈',
+	{0x6C, 0x4C}: '賁',
+	{0x6C, 0x4D}: '賤',
+	{0x6C, 0x4E}: '賣',
+	{0x6C, 0x4F}: '賚',
+	{0x6C, 0x50}: '賽',
+	{0x6C, 0x51}: '賺',
+	{0x6C, 0x52}: '賻',
+	{0x6C, 0x53}: '贄',
+	{0x6C, 0x54}: '贅',
+	{0x6C, 0x55}: '贊',
+	{0x6C, 0x56}: '贇',
+	{0x6C, 0x57}: '贏',
+	{0x6C, 0x58}: '贍',
+	{0x6C, 0x59}: '贐',
+	{0x6C, 0x5A}: '齎',
+	{0x6C, 0x5B}: '贓',
+	{0x6C, 0x5C}: '賍',
+	{0x6C, 0x5D}: '贔',
+	{0x6C, 0x5E}: '贖',
+	{0x6C, 0x5F}: '赧',
+	{0x6C, 0x60}: '赭',
+	{0x6C, 0x61}: '赱',
+	{0x6C, 0x62}: '赳',
+	{0x6C, 0x63}: '趁',
+	{0x6C, 0x64}: '趙',
+	{0x6C, 0x65}: '跂',
+	{0x6C, 0x66}: '趾',
+	{0x6C, 0x67}: '趺',
+	{0x6C, 0x68}: '跏',
+	{0x6C, 0x69}: '跚',
+	{0x6C, 0x6A}: '跖',
+	{0x6C, 0x6B}: '跌',
+	{0x6C, 0x6C}: '跛',
+	{0x6C, 0x6D}: '跋',
+	{0x6C, 0x6E}: '跪',
+	{0x6C, 0x6F}: '跫',
+	{0x6C, 0x70}: '跟',
+	{0x6C, 0x71}: '跣',
+	{0x6C, 0x72}: '跼',
+	{0x6C, 0x73}: '踈',
+	{0x6C, 0x74}: '踉',
+	{0x6C, 0x75}: '跿',
+	{0x6C, 0x76}: '踝',
+	{0x6C, 0x77}: '踞',
+	{0x6C, 0x78}: '踐',
+	{0x6C, 0x79}: '踟',
+	{0x6C, 0x7A}: '蹂',
+	{0x6C, 0x7B}: '踵',
+	{0x6C, 0x7C}: '踰',
+	{0x6C, 0x7D}: '踴',
+	{0x6C, 0x7E}: '蹊',
+	{0x6D, 0x21}: '蹇',
+	{0x6D, 0x22}: '蹉',
+	{0x6D, 0x23}: '蹌',
+	{0x6D, 0x24}: '蹐',
+	{0x6D, 0x25}: '蹈',
+	{0x6D, 0x26}: '蹙',
+	{0x6D, 0x27}: '蹤',
+	{0x6D, 0x28}: '蹠',
+	{0x6D, 0x29}: '踪',
+	{0x6D, 0x2A}: '蹣',
+	{0x6D, 0x2B}: '蹕',
+	{0x6D, 0x2C}: '蹶',
+	{0x6D, 0x2D}: '蹲',
+	{0x6D, 0x2E}: '蹼',
+	{0x6D, 0x2F}: '躁',
+	{0x6D, 0x30}: '躇',
+	{0x6D, 0x31}: '躅',
+	{0x6D, 0x32}: '躄',
+	{0x6D, 0x33}: '躋',
+	{0x6D, 0x34}: '躊',
+	{0x6D, 0x35}: '躓',
+	{0x6D, 0x36}: '躑',
+	{0x6D, 0x37}: '躔',
+	{0x6D, 0x38}: '躙',
+	{0x6D, 0x39}: '躪',
+	{0x6D, 0x3A}: '躡',
+	{0x6D, 0x3B}: '躬',
+	{0x6D, 0x3C}: '躰',
+	{0x6D, 0x3D}: '軆',
+	{0x6D, 0x3E}: '躱',
+	{0x6D, 0x3F}: '躾',
+	{0x6D, 0x40}: '軅',
+	{0x6D, 0x41}: '軈',
+	{0x6D, 0x42}: '軋',
+	{0x6D, 0x43}: '軛',
+	{0x6D, 0x44}: '軣',
+	{0x6D, 0x45}: '軼',
+	{0x6D, 0x46}: '軻',
+	{0x6D, 0x47}: '軫',
+	{0x6D, 0x48}: '軾',
+	{0x6D, 0x49}: '輊',
+	{0x6D, 0x4A}: '輅',
+	{0x6D, 0x4B}: '輕',
+	{0x6D, 0x4C}: '輒',
+	{0x6D, 0x4D}: '輙',
+	{0x6D, 0x4E}: '輓',
+	{0x6D, 0x4F}: '輜',
+	{0x6D, 0x50}: '輟',
+	{0x6D, 0x51}: '輛',
+	{0x6D, 0x52}: '輌',
+	{0x6D, 0x53}: '輦',
+	{0x6D, 0x54}: '輳',
+	{0x6D, 0x55}: '輻',
+	{0x6D, 0x56}: '輹',
+	{0x6D, 0x57}: '轅',
+	{0x6D, 0x58}: '轂',
+	{0x6D, 0x59}: '輾',
+	{0x6D, 0x5A}: '轌',
+	{0x6D, 0x5B}: '轉',
+	{0x6D, 0x5C}: '轆',
+	{0x6D, 0x5D}: '轎',
+	{0x6D, 0x5E}: '轗',
+	{0x6D, 0x5F}: '轜',
+	{0x6D, 0x60}: '轢',
+	{0x6D, 0x61}: '轣',
+	{0x6D, 0x62}: '轤',
+	{0x6D, 0x63}: '辜',
+	{0x6D, 0x64}: '辟',
+	{0x6D, 0x65}: '辣',
+	{0x6D, 0x66}: '辭',
+	{0x6D, 0x67}: '辯',
+	{0x6D, 0x68}: '辷',
+	{0x6D, 0x69}: '迚',
+	{0x6D, 0x6A}: '迥',
+	{0x6D, 0x6B}: '迢',
+	{0x6D, 0x6C}: '迪',
+	{0x6D, 0x6D}: '迯',
+	{0x6D, 0x6E}: '邇',
+	{0x6D, 0x6F}: '迴',
+	{0x6D, 0x70}: '逅',
+	{0x6D, 0x71}: '迹',
+	{0x6D, 0x72}: '迺',
+	{0x6D, 0x73}: '逑',
+	{0x6D, 0x74}: '逕',
+	{0x6D, 0x75}: '逡',
+	{0x6D, 0x76}: '逍',
+	{0x6D, 0x77}: '逞',
+	{0x6D, 0x78}: '逖',
+	{0x6D, 0x79}: '逋',
+	{0x6D, 0x7A}: '逧',
+	{0x6D, 0x7B}: '逶',
+	{0x6D, 0x7C}: '逵',
+	{0x6D, 0x7D}: '逹',
+	{0x6D, 0x7E}: '迸',
+	{0x6E, 0x21}: '遏',
+	{0x6E, 0x22}: '遐',
+	{0x6E, 0x23}: '遑',
+	{0x6E, 0x24}: '遒',
+	{0x6E, 0x25}: '逎',
+	{0x6E, 0x26}: '遉',
+	{0x6E, 0x27}: '逾',
+	{0x6E, 0x28}: '遖',
+	{0x6E, 0x29}: '遘',
+	{0x6E, 0x2A}: '遞',
+	{0x6E, 0x2B}: '遨',
+	{0x6E, 0x2C}: '遯',
+	{0x6E, 0x2D}: '遶',
+	{0x6E, 0x2E}: '隨',
+	{0x6E, 0x2F}: '遲',
+	{0x6E, 0x30}: '邂',
+	{0x6E, 0x31}: '遽',
+	{0x6E, 0x32}: '邁',
+	{0x6E, 0x33}: '邀',
+	{0x6E, 0x34}: '邊',
+	{0x6E, 0x35}: '邉',
+	{0x6E, 0x36}: '邏',
+	{0x6E, 0x37}: '邨',
+	{0x6E, 0x38}: '邯',
+	{0x6E, 0x39}: '邱',
+	{0x6E, 0x3A}: '邵',
+	{0x6E, 0x3B}: '郢',
+	{0x6E, 0x3C}: '郤',
+	{0x6E, 0x3D}: '扈',
+	{0x6E, 0x3E}: '郛',
+	{0x6E, 0x3F}: '鄂',
+	{0x6E, 0x40}: '鄒',
+	{0x6E, 0x41}: '鄙',
+	{0x6E, 0x42}: '鄲',
+	{0x6E, 0x43}: '鄰',
+	{0x6E, 0x44}: '酊',
+	{0x6E, 0x45}: '酖',
+	{0x6E, 0x46}: '酘',
+	{0x6E, 0x47}: '酣',
+	{0x6E, 0x48}: '酥',
+	{0x6E, 0x49}: '酩',
+	{0x6E, 0x4A}: '酳',
+	{0x6E, 0x4B}: '酲',
+	{0x6E, 0x4C}: '醋',
+	{0x6E, 0x4D}: '醉',
+	{0x6E, 0x4E}: '醂',
+	{0x6E, 0x4F}: '醢',
+	{0x6E, 0x50}: '醫',
+	{0x6E, 0x51}: '醯',
+	{0x6E, 0x52}: '醪',
+	{0x6E, 0x53}: '醵',
+	{0x6E, 0x54}: '醴',
+	{0x6E, 0x55}: '醺',
+	{0x6E, 0x56}: '釀',
+	{0x6E, 0x57}: '釁',
+	{0x6E, 0x58}: '釉',
+	{0x6E, 0x59}: '釋',
+	{0x6E, 0x5A}: '釐',
+	{0x6E, 0x5B}: '釖',
+	{0x6E, 0x5C}: '釟',
+	{0x6E, 0x5D}: '釡',
+	{0x6E, 0x5E}: '釛',
+	{0x6E, 0x5F}: '釼',
+	{0x6E, 0x60}: '釵',
+	{0x6E, 0x61}: '釶',
+	{0x6E, 0x62}: '鈞',
+	{0x6E, 0x63}: '釿',
+	{0x6E, 0x64}: '鈔',
+	{0x6E, 0x65}: '鈬',
+	{0x6E, 0x66}: '鈕',
+	{0x6E, 0x67}: '鈑',
+	{0x6E, 0x68}: '鉞',
+	{0x6E, 0x69}: '鉗',
+	{0x6E, 0x6A}: '鉅',
+	{0x6E, 0x6B}: '鉉',
+	{0x6E, 0x6C}: '鉤',
+	{0x6E, 0x6D}: '鉈',
+	{0x6E, 0x6E}: '銕',
+	{0x6E, 0x6F}: '鈿',
+	{0x6E, 0x70}: '鉋',
+	{0x6E, 0x71}: '鉐',
+	{0x6E, 0x72}: '銜',
+	{0x6E, 0x73}: '銖',
+	{0x6E, 0x74}: '銓',
+	{0x6E, 0x75}: '銛',
+	{0x6E, 0x76}: '鉚',
+	{0x6E, 0x77}: '鋏',
+	{0x6E, 0x78}: '銹',
+	{0x6E, 0x79}: '銷',
+	{0x6E, 0x7A}: '鋩',
+	{0x6E, 0x7B}: '錏',
+	{0x6E, 0x7C}: '鋺',
+	{0x6E, 0x7D}: '鍄',
+	{0x6E, 0x7E}: '錮',
+	{0x6F, 0x21}: '錙',
+	{0x6F, 0x22}: '錢',
+	{0x6F, 0x23}: '錚',
+	{0x6F, 0x24}: '錣',
+	{0x6F, 0x25}: '錺',
+	{0x6F, 0x26}: '錵',
+	{0x6F, 0x27}: '錻',
+	{0x6F, 0x28}: '鍜',
+	{0x6F, 0x29}: '鍠',
+	{0x6F, 0x2A}: '鍼',
+	{0x6F, 0x2B}: '鍮',
+	{0x6F, 0x2C}: '鍖',
+	{0x6F, 0x2D}: '鎰',
+	{0x6F, 0x2E}: '鎬',
+	{0x6F, 0x2F}: '鎭',
+	{0x6F, 0x30}: '鎔',
+	{0x6F, 0x31}: '鎹',
+	{0x6F, 0x32}: '鏖',
+	{0x6F, 0x33}: '鏗',
+	{0x6F, 0x34}: '鏨',
+	{0x6F, 0x35}: '鏥',
+	{0x6F, 0x36}: '鏘',
+	{0x6F, 0x37}: '鏃',
+	{0x6F, 0x38}: '鏝',
+	{0x6F, 0x39}: '鏐',
+	{0x6F, 0x3A}: '鏈',
+	{0x6F, 0x3B}: '鏤',
+	{0x6F, 0x3C}: '鐚',
+	{0x6F, 0x3D}: '鐔',
+	{0x6F, 0x3E}: '鐓',
+	{0x6F, 0x3F}: '鐃',
+	{0x6F, 0x40}: '鐇',
+	{0x6F, 0x41}: '鐐',
+	{0x6F, 0x42}: '鐶',
+	{0x6F, 0x43}: '鐫',
+	{0x6F, 0x44}: '鐵',
+	{0x6F, 0x45}: '鐡',
+	{0x6F, 0x46}: '鐺',
+	{0x6F, 0x47}: '鑁',
+	{0x6F, 0x48}: '鑒',
+	{0x6F, 0x49}: '鑄',
+	{0x6F, 0x4A}: '鑛',
+	{0x6F, 0x4B}: '鑠',
+	{0x6F, 0x4C}: '鑢',
+	{0x6F, 0x4D}: '鑞',
+	{0x6F, 0x4E}: '鑪',
+	{0x6F, 0x4F}: '鈩',
+	{0x6F, 0x50}: '鑰',
+	{0x6F, 0x51}: '鑵',
+	{0x6F, 0x52}: '鑷',
+	{0x6F, 0x53}: '鑽',
+	{0x6F, 0x54}: '鑚',
+	{0x6F, 0x55}: '鑼',
+	{0x6F, 0x56}: '鑾',
+	{0x6F, 0x57}: '钁',
+	{0x6F, 0x58}: '鑿',
+	{0x6F, 0x59}: '閂',
+	{0x6F, 0x5A}: '閇',
+	{0x6F, 0x5B}: '閊',
+	{0x6F, 0x5C}: '閔',
+	{0x6F, 0x5D}: '閖',
+	{0x6F, 0x5E}: '閘',
+	{0x6F, 0x5F}: '閙',
+	{0x6F, 0x60}: '閠',
+	{0x6F, 0x61}: '閨',
+	{0x6F, 0x62}: '閧',
+	{0x6F, 0x63}: '閭',
+	{0x6F, 0x64}: '閼',
+	{0x6F, 0x65}: '閻',
+	{0x6F, 0x66}: '閹',
+	{0x6F, 0x67}: '閾',
+	{0x6F, 0x68}: '闊',
+	{0x6F, 0x69}: '濶',
+	{0x6F, 0x6A}: '闃',
+	{0x6F, 0x6B}: '闍',
+	{0x6F, 0x6C}: '闌',
+	{0x6F, 0x6D}: '闕',
+	{0x6F, 0x6E}: '闔',
+	{0x6F, 0x6F}: '闖',
+	{0x6F, 0x70}: '關',
+	{0x6F, 0x71}: '闡',
+	{0x6F, 0x72}: '闥',
+	{0x6F, 0x73}: '闢',
+	{0x6F, 0x74}: '阡',
+	{0x6F, 0x75}: '阨',
+	{0x6F, 0x76}: '阮',
+	{0x6F, 0x77}: '阯',
+	{0x6F, 0x78}: '陂',
+	{0x6F, 0x79}: '陌',
+	{0x6F, 0x7A}: '陏',
+	{0x6F, 0x7B}: '陋',
+	{0x6F, 0x7C}: '陷',
+	{0x6F, 0x7D}: '陜',
+	{0x6F, 0x7E}: '陞',
+	{0x70, 0x21}: '陝',
+	{0x70, 0x22}: '陟',
+	{0x70, 0x23}: '陦',
+	{0x70, 0x24}: '陲',
+	{0x70, 0x25}: '陬',
+	{0x70, 0x26}: '隍',
+	{0x70, 0x27}: '隘',
+	{0x70, 0x28}: '隕',
+	{0x70, 0x29}: '隗',
+	{0x70, 0x2A}: '險',
+	{0x70, 0x2B}: '隧',
+	{0x70, 0x2C}: '隱',
+	{0x70, 0x2D}: '隲',
+	{0x70, 0x2E}: '隰',
+	{0x70, 0x2F}: '隴',
+	{0x70, 0x30}: '隶',
+	{0x70, 0x31}: '隸',
+	{0x70, 0x32}: '隹',
+	{0x70, 0x33}: '雎',
+	{0x70, 0x34}: '雋',
+	{0x70, 0x35}: '雉',
+	{0x70, 0x36}: '雍',
+	{0x70, 0x37}: '襍',
+	{0x70, 0x38}: '雜',
+	{0x70, 0x39}: '霍',
+	{0x70, 0x3A}: '雕',
+	{0x70, 0x3B}: '雹',
+	{0x70, 0x3C}: '霄',
+	{0x70, 0x3D}: '霆',
+	{0x70, 0x3E}: '霈',
+	{0x70, 0x3F}: '霓',
+	{0x70, 0x40}: '霎',
+	{0x70, 0x41}: '霑',
+	{0x70, 0x42}: '霏',
+	{0x70, 0x43}: '霖',
+	{0x70, 0x44}: '霙',
+	{0x70, 0x45}: '霤',
+	{0x70, 0x46}: '霪',
+	{0x70, 0x47}: '霰',
+	{0x70, 0x48}: '霹',
+	{0x70, 0x49}: '霽',
+	{0x70, 0x4A}: '霾',
+	{0x70, 0x4B}: '靄',
+	{0x70, 0x4C}: '靆',
+	{0x70, 0x4D}: '靈',
+	{0x70, 0x4E}: '靂',
+	{0x70, 0x4F}: '靉',
+	{0x70, 0x50}: '靜',
+	{0x70, 0x51}: '靠',
+	{0x70, 0x52}: '靤',
+	{0x70, 0x53}: '靦',
+	{0x70, 0x54}: '靨',
+	{0x70, 0x55}: '勒',
+	{0x70, 0x56}: '靫',
+	{0x70, 0x57}: '靱',
+	{0x70, 0x58}: '靹',
+	{0x70, 0x59}: '鞅',
+	{0x70, 0x5A}: '靼',
+	{0x70, 0x5B}: '鞁',
+	{0x70, 0x5C}: '靺',
+	{0x70, 0x5D}: '鞆',
+	{0x70, 0x5E}: '鞋',
+	{0x70, 0x5F}: '鞏',
+	{0x70, 0x60}: '鞐',
+	{0x70, 0x61}: '鞜',
+	{0x70, 0x62}: '鞨',
+	{0x70, 0x63}: '鞦',
+	{0x70, 0x64}: '鞣',
+	{0x70, 0x65}: '鞳',
+	{0x70, 0x66}: '鞴',
+	{0x70, 0x67}: '韃',
+	{0x70, 0x68}: '韆',
+	{0x70, 0x69}: '韈',
+	{0x70, 0x6A}: '韋',
+	{0x70, 0x6B}: '韜',
+	{0x70, 0x6C}: '韭',
+	{0x70, 0x6D}: '齏',
+	{0x70, 0x6E}: '韲',
+	{0x70, 0x6F}: '竟',
+	{0x70, 0x70}: '韶',
+	{0x70, 0x71}: '韵',
+	{0x70, 0x72}: '頏',
+	{0x70, 0x73}: '頌',
+	{0x70, 0x74}: '頸',
+	{0x70, 0x75}: '頤',
+	{0x70, 0x76}: '頡',
+	{0x70, 0x77}: '頷',
+	{0x70, 0x78}: '頽',
+	{0x70, 0x79}: '顆',
+	{0x70, 0x7A}: '顏',
+	{0x70, 0x7B}: '顋',
+	{0x70, 0x7C}: '顫',
+	{0x70, 0x7D}: '顯',
+	{0x70, 0x7E}: '顰',
+	{0x71, 0x21}: '顱',
+	{0x71, 0x22}: '顴',
+	{0x71, 0x23}: '顳',
+	{0x71, 0x24}: '颪',
+	{0x71, 0x25}: '颯',
+	{0x71, 0x26}: '颱',
+	{0x71, 0x27}: '颶',
+	{0x71, 0x28}: '飄',
+	{0x71, 0x29}: '飃',
+	{0x71, 0x2A}: '飆',
+	{0x71, 0x2B}: '飩',
+	{0x71, 0x2C}: '飫',
+	{0x71, 0x2D}: '餃',
+	{0x71, 0x2E}: '餉',
+	{0x71, 0x2F}: '餒',
+	{0x71, 0x30}: '餔',
+	{0x71, 0x31}: '餘',
+	{0x71, 0x32}: '餡',
+	{0x71, 0x33}: '餝',
+	{0x71, 0x34}: '餞',
+	{0x71, 0x35}: '餤',
+	{0x71, 0x36}: '餠',
+	{0x71, 0x37}: '餬',
+	{0x71, 0x38}: '餮',
+	{0x71, 0x39}: '餽',
+	{0x71, 0x3A}: '餾',
+	{0x71, 0x3B}: '饂',
+	{0x71, 0x3C}: '饉',
+	{0x71, 0x3D}: '饅',
+	{0x71, 0x3E}: '饐',
+	{0x71, 0x3F}: '饋',
+	{0x71, 0x40}: '饑',
+	{0x71, 0x41}: '饒',
+	{0x71, 0x42}: '饌',
+	{0x71, 0x43}: '饕',
+	{0x71, 0x44}: '馗',
+	{0x71, 0x45}: '馘',
+	{0x71, 0x46}: '馥',
+	{0x71, 0x47}: '馭',
+	{0x71, 0x48}: '馮',
+	{0x71, 0x49}: '馼',
+	{0x71, 0x4A}: '駟',
+	{0x71, 0x4B}: '駛',
+	{0x71, 0x4C}: '駝',
+	{0x71, 0x4D}: '駘',
+	{0x71, 0x4E}: '駑',
+	{0x71, 0x4F}: '駭',
+	{0x71, 0x50}: '駮',
+	{0x71, 0x51}: '駱',
+	{0x71, 0x52}: '駲',
+	{0x71, 0x53}: '駻',
+	{0x71, 0x54}: '駸',
+	{0x71, 0x55}: '騁',
+	{0x71, 0x56}: '騏',
+	{0x71, 0x57}: '騅',
+	{0x71, 0x58}: '駢',
+	{0x71, 0x59}: '騙',
+	{0x71, 0x5A}: '騫',
+	{0x71, 0x5B}: '騷',
+	{0x71, 0x5C}: '驅',
+	{0x71, 0x5D}: '驂',
+	{0x71, 0x5E}: '驀',
+	{0x71, 0x5F}: '驃',
+	{0x71, 0x60}: '騾',
+	{0x71, 0x61}: '驕',
+	{0x71, 0x62}: '驍',
+	{0x71, 0x63}: '驛',
+	{0x71, 0x64}: '驗',
+	{0x71, 0x65}: '驟',
+	{0x71, 0x66}: '驢',
+	{0x71, 0x67}: '驥',
+	{0x71, 0x68}: '驤',
+	{0x71, 0x69}: '驩',
+	{0x71, 0x6A}: '驫',
+	{0x71, 0x6B}: '驪',
+	{0x71, 0x6C}: '骭',
+	{0x71, 0x6D}: '骰',
+	{0x71, 0x6E}: '骼',
+	{0x71, 0x6F}: '髀',
+	{0x71, 0x70}: '髏',
+	{0x71, 0x71}: '髑',
+	{0x71, 0x72}: '髓',
+	{0x71, 0x73}: '體',
+	{0x71, 0x74}: '髞',
+	{0x71, 0x75}: '髟',
+	{0x71, 0x76}: '髢',
+	{0x71, 0x77}: '髣',
+	{0x71, 0x78}: '髦',
+	{0x71, 0x79}: '髯',
+	{0x71, 0x7A}: '髫',
+	{0x71, 0x7B}: '髮',
+	{0x71, 0x7C}: '髴',
+	{0x71, 0x7D}: '髱',
+	{0x71, 0x7E}: '髷',
+	{0x72, 0x21}: '髻',
+	{0x72, 0x22}: '鬆',
+	{0x72, 0x23}: '鬘',
+	{0x72, 0x24}: '鬚',
+	{0x72, 0x25}: '鬟',
+	{0x72, 0x26}: '鬢',
+	{0x72, 0x27}: '鬣',
+	{0x72, 0x28}: '鬥',
+	{0x72, 0x29}: '鬧',
+	{0x72, 0x2A}: '鬨',
+	{0x72, 0x2B}: '鬩',
+	{0x72, 0x2C}: '鬪',
+	{0x72, 0x2D}: '鬮',
+	{0x72, 0x2E}: '鬯',
+	{0x72, 0x2F}: '鬲',
+	{0x72, 0x30}: '魄',
+	{0x72, 0x31}: '魃',
+	{0x72, 0x32}: '魏',
+	{0x72, 0x33}: '魍',
+	{0x72, 0x34}: '魎',
+	{0x72, 0x35}: '魑',
+	{0x72, 0x36}: '魘',
+	{0x72, 0x37}: '魴',
+	{0x72, 0x38}: '鮓',
+	{0x72, 0x39}: '鮃',
+	{0x72, 0x3A}: '鮑',
+	{0x72, 0x3B}: '鮖',
+	{0x72, 0x3C}: '鮗',
+	{0x72, 0x3D}: '鮟',
+	{0x72, 0x3E}: '鮠',
+	{0x72, 0x3F}: '鮨',
+	{0x72, 0x40}: '鮴',
+	{0x72, 0x41}: '鯀',
+	{0x72, 0x42}: '鯊',
+	{0x72, 0x43}: '鮹',
+	{0x72, 0x44}: '鯆',
+	{0x72, 0x45}: '鯏',
+	{0x72, 0x46}: '鯑',
+	{0x72, 0x47}: '鯒',
+	{0x72, 0x48}: '鯣',
+	{0x72, 0x49}: '鯢',
+	{0x72, 0x4A}: '鯤',
+	{0x72, 0x4B}: '鯔',
+	{0x72, 0x4C}: '鯡',
+	{0x72, 0x4D}: '鰺',
+	{0x72, 0x4E}: '鯲',
+	{0x72, 0x4F}: '鯱',
+	{0x72, 0x50}: '鯰',
+	{0x72, 0x51}: '鰕',
+	{0x72, 0x52}: '鰔',
+	{0x72, 0x53}: '鰉',
+	{0x72, 0x54}: '鰓',
+	{0x72, 0x55}: '鰌',
+	{0x72, 0x56}: '鰆',
+	{0x72, 0x57}: '鰈',
+	{0x72, 0x58}: '鰒',
+	{0x72, 0x59}: '鰊',
+	{0x72, 0x5A}: '鰄',
+	{0x72, 0x5B}: '鰮',
+	{0x72, 0x5C}: '鰛',
+	{0x72, 0x5D}: '鰥',
+	{0x72, 0x5E}: '鰤',
+	{0x72, 0x5F}: '鰡',
+	{0x72, 0x60}: '鰰',
+	{0x72, 0x61}: '鱇',
+	{0x72, 0x62}: '鰲',
+	{0x72, 0x63}: '鱆',
+	{0x72, 0x64}: '鰾',
+	{0x72, 0x65}: '鱚',
+	{0x72, 0x66}: '鱠',
+	{0x72, 0x67}: '鱧',
+	{0x72, 0x68}: '鱶',
+	{0x72, 0x69}: '鱸',
+	{0x72, 0x6A}: '鳧',
+	{0x72, 0x6B}: '鳬',
+	{0x72, 0x6C}: '鳰',
+	{0x72, 0x6D}: '鴉',
+	{0x72, 0x6E}: '鴈',
+	{0x72, 0x6F}: '鳫',
+	{0x72, 0x70}: '鴃',
+	{0x72, 0x71}: '鴆',
+	{0x72, 0x72}: '鴪',
+	{0x72, 0x73}: '鴦',
+	{0x72, 0x74}: '鶯',
+	{0x72, 0x75}: '鴣',
+	{0x72, 0x76}: '鴟',
+	{0x72, 0x77}: '鵄',
+	{0x72, 0x78}: '鴕',
+	{0x72, 0x79}: '鴒',
+	{0x72, 0x7A}: '鵁',
+	{0x72, 0x7B}: '鴿',
+	{0x72, 0x7C}: '鴾',
+	{0x72, 0x7D}: '鵆',
+	{0x72, 0x7E}: '鵈',
+	{0x73, 0x21}: '鵝',
+	{0x73, 0x22}: '鵞',
+	{0x73, 0x23}: '鵤',
+	{0x73, 0x24}: '鵑',
+	{0x73, 0x25}: '鵐',
+	{0x73, 0x26}: '鵙',
+	{0x73, 0x27}: '鵲',
+	{0x73, 0x28}: '鶉',
+	{0x73, 0x29}: '鶇',
+	{0x73, 0x2A}: '鶫',
+	{0x73, 0x2B}: '鵯',
+	{0x73, 0x2C}: '鵺',
+	{0x73, 0x2D}: '鶚',
+	{0x73, 0x2E}: '鶤',
+	{0x73, 0x2F}: '鶩',
+	{0x73, 0x30}: '鶲',
+	{0x73, 0x31}: '鷄',
+	{0x73, 0x32}: '鷁',
+	{0x73, 0x33}: '鶻',
+	{0x73, 0x34}: '鶸',
+	{0x73, 0x35}: '鶺',
+	{0x73, 0x36}: '鷆',
+	{0x73, 0x37}: '鷏',
+	{0x73, 0x38}: '鷂',
+	{0x73, 0x39}: '鷙',
+	{0x73, 0x3A}: '鷓',
+	{0x73, 0x3B}: '鷸',
+	{0x73, 0x3C}: '鷦',
+	{0x73, 0x3D}: '鷭',
+	{0x73, 0x3E}: '鷯',
+	{0x73, 0x3F}: '鷽',
+	{0x73, 0x40}: '鸚',
+	{0x73, 0x41}: '鸛',
+	{0x73, 0x42}: '鸞',
+	{0x73, 0x43}: '鹵',
+	{0x73, 0x44}: '鹹',
+	{0x73, 0x45}: '鹽',
+	{0x73, 0x46}: '麁',
+	{0x73, 0x47}: '麈',
+	{0x73, 0x48}: '麋',
+	{0x73, 0x49}: '麌',
+	{0x73, 0x4A}: '麒',
+	{0x73, 0x4B}: '麕',
+	{0x73, 0x4C}: '麑',
+	{0x73, 0x4D}: '麝',
+	{0x73, 0x4E}: '麥',
+	{0x73, 0x4F}: '麩',
+	{0x73, 0x50}: '麸',
+	{0x73, 0x51}: '麪',
+	{0x73, 0x52}: '麭',
+	{0x73, 0x53}: '靡',
+	{0x73, 0x54}: '黌',
+	{0x73, 0x55}: '黎',
+	{0x73, 0x56}: '黏',
+	{0x73, 0x57}: '黐',
+	{0x73, 0x58}: '黔',
+	{0x73, 0x59}: '黜',
+	{0x73, 0x5A}: '點',
+	{0x73, 0x5B}: '黝',
+	{0x73, 0x5C}: '黠',
+	{0x73, 0x5D}: '黥',
+	{0x73, 0x5E}: '黨',
+	{0x73, 0x5F}: '黯',
+	{0x73, 0x60}: '黴',
+	{0x73, 0x61}: '黶',
+	{0x73, 0x62}: '黷',
+	{0x73, 0x63}: '黹',
+	{0x73, 0x64}: '黻',
+	{0x73, 0x65}: '黼',
+	{0x73, 0x66}: '黽',
+	{0x73, 0x67}: '鼇',
+	{0x73, 0x68}: '鼈',
+	{0x73, 0x69}: '皷',
+	{0x73, 0x6A}: '鼕',
+	{0x73, 0x6B}: '鼡',
+	{0x73, 0x6C}: '鼬',
+	{0x73, 0x6D}: '鼾',
+	{0x73, 0x6E}: '齊',
+	{0x73, 0x6F}: '齒',
+	{0x73, 0x70}: '齔',
+	{0x73, 0x71}: '齣',
+	{0x73, 0x72}: '齟',
+	{0x73, 0x73}: '齠',
+	{0x73, 0x74}: '齡',
+	{0x73, 0x75}: '齦',
+	{0x73, 0x76}: '齧',
+	{0x73, 0x77}: '齬',
+	{0x73, 0x78}: '齪',
+	{0x73, 0x79}: '齷',
+	{0x73, 0x7A}: '齲',
+	{0x73, 0x7B}: '齶',
+	{0x73, 0x7C}: '龕',
+	{0x73, 0x7D}: '龜',
+	{0x73, 0x7E}: '龠',
+	{0x74, 0x21}: '堯',
+	{0x74, 0x22}: '槇',
+	{0x74, 0x23}: '遙',
+	{0x74, 0x24}: '瑤',
+	{0x74, 0x25}: '凜',
+	{0x74, 0x26}: '熙',
+	{0x79, 0x21}: '纊',
+	{0x79, 0x22}: '褜',
+	{0x79, 0x23}: '鍈',
+	{0x79, 0x24}: '銈',
+	{0x79, 0x25}: '蓜',
+	{0x79, 0x26}: '俉',
+	{0x79, 0x27}: '炻',
+	{0x79, 0x28}: '昱',
+	{0x79, 0x29}: '棈',
+	{0x79, 0x2A}: '鋹',
+	{0x79, 0x2B}: '曻',
+	{0x79, 0x2C}: '彅',
+	{0x79, 0x2D}: '丨',
+	{0x79, 0x2E}: '仡',
+	{0x79, 0x2F}: '仼',
+	{0x79, 0x30}: '伀',
+	{0x79, 0x31}: '伃',
+	{0x79, 0x32}: '伹',
+	{0x79, 0x33}: '佖',
+	{0x79, 0x34}: '侒',
+	{0x79, 0x35}: '侊',
+	{0x79, 0x36}: '侚',
+	{0x79, 0x37}: '侔',
+	{0x79, 0x38}: '俍',
+	{0x79, 0x39}: '偀',
+	{0x79, 0x3A}: '倢',
+	{0x79, 0x3B}: '俿',
+	{0x79, 0x3C}: '倞',
+	{0x79, 0x3D}: '偆',
+	{0x79, 0x3E}: '偰',
+	{0x79, 0x3F}: '偂',
+	{0x79, 0x40}: '傔',
+	{0x79, 0x41}: '僴',
+	{0x79, 0x42}: '僘',
+	{0x79, 0x43}: '兊',
+	{0x79, 0x44}: '兤',
+	{0x79, 0x45}: '冝',
+	{0x79, 0x46}: '冾',
+	{0x79, 0x47}: '凬',
+	{0x79, 0x48}: '刕',
+	{0x79, 0x49}: '劜',
+	{0x79, 0x4A}: '劦',
+	{0x79, 0x4B}: '勀',
+	{0x79, 0x4C}: '勛',
+	{0x79, 0x4D}: '匀',
+	{0x79, 0x4E}: '匇',
+	{0x79, 0x4F}: '匤',
+	{0x79, 0x50}: '卲',
+	{0x79, 0x51}: '厓',
+	{0x79, 0x52}: '厲',
+	{0x79, 0x53}: '叝',
+	{0x79, 0x54}: '﨎',
+	{0x79, 0x55}: '咜',
+	{0x79, 0x56}: '咊',
+	{0x79, 0x57}: '咩',
+	{0x79, 0x58}: '哿',
+	{0x79, 0x59}: '喆',
+	{0x79, 0x5A}: '坙',
+	{0x79, 0x5B}: '坥',
+	{0x79, 0x5C}: '垬',
+	{0x79, 0x5D}: '埈',
+	{0x79, 0x5E}: '埇',
+	{0x79, 0x5F}: '﨏',
+	{0x79, 0x60}: '塚',
+	{0x79, 0x61}: '增',
+	{0x79, 0x62}: '墲',
+	{0x79, 0x63}: '夋',
+	{0x79, 0x64}: '奓',
+	{0x79, 0x65}: '奛',
+	{0x79, 0x66}: '奝',
+	{0x79, 0x67}: '奣',
+	{0x79, 0x68}: '妤',
+	{0x79, 0x69}: '妺',
+	{0x79, 0x6A}: '孖',
+	{0x79, 0x6B}: '寀',
+	{0x79, 0x6C}: '甯',
+	{0x79, 0x6D}: '寘',
+	{0x79, 0x6E}: '寬',
+	{0x79, 0x6F}: '尞',
+	{0x79, 0x70}: '岦',
+	{0x79, 0x71}: '岺',
+	{0x79, 0x72}: '峵',
+	{0x79, 0x73}: '崧',
+	{0x79, 0x74}: '嵓',
+	{0x79, 0x75}: '﨑',
+	{0x79, 0x76}: '嵂',
+	{0x79, 0x77}: '嵭',
+	{0x79, 0x78}: '嶸',
+	{0x79, 0x79}: '嶹',
+	{0x79, 0x7A}: '巐',
+	{0x79, 0x7B}: '弡',
+	{0x79, 0x7C}: '弴',
+	{0x79, 0x7D}: '彧',
+	{0x79, 0x7E}: '德',
+	{0x7A, 0x21}: '忞',
+	{0x7A, 0x22}: '恝',
+	{0x7A, 0x23}: '悅',
+	{0x7A, 0x24}: '悊',
+	{0x7A, 0x25}: '惞',
+	{0x7A, 0x26}: '惕',
+	{0x7A, 0x27}: '愠',
+	{0x7A, 0x28}: '惲',
+	{0x7A, 0x29}: '愑',
+	{0x7A, 0x2A}: '愷',
+	{0x7A, 0x2B}: '愰',
+	{0x7A, 0x2C}: '憘',
+	{0x7A, 0x2D}: '戓',
+	{0x7A, 0x2E}: '抦',
+	{0x7A, 0x2F}: '揵',
+	{0x7A, 0x30}: '摠',
+	{0x7A, 0x31}: '撝',
+	{0x7A, 0x32}: '擎',
+	{0x7A, 0x33}: '敎',
+	{0x7A, 0x34}: '昀',
+	{0x7A, 0x35}: '昕',
+	{0x7A, 0x36}: '昻',
+	{0x7A, 0x37}: '昉',
+	{0x7A, 0x38}: '昮',
+	{0x7A, 0x39}: '昞',
+	{0x7A, 0x3A}: '昤',
+	{0x7A, 0x3B}: '晥',
+	{0x7A, 0x3C}: '晗',
+	{0x7A, 0x3D}: '晙',
+	{0x7A, 0x3E}: '晴',
+	{0x7A, 0x3F}: '晳',
+	{0x7A, 0x40}: '暙',
+	{0x7A, 0x41}: '暠',
+	{0x7A, 0x42}: '暲',
+	{0x7A, 0x43}: '暿',
+	{0x7A, 0x44}: '曺',
+	{0x7A, 0x45}: '朎',
+	{0x7A, 0x46}: '朗',
+	{0x7A, 0x47}: '杦',
+	{0x7A, 0x48}: '枻',
+	{0x7A, 0x49}: '桒',
+	{0x7A, 0x4A}: '柀',
+	{0x7A, 0x4B}: '栁',
+	{0x7A, 0x4C}: '桄',
+	{0x7A, 0x4D}: '棏',
+	{0x7A, 0x4E}: '﨓',
+	{0x7A, 0x4F}: '楨',
+	{0x7A, 0x50}: '﨔',
+	{0x7A, 0x51}: '榘',
+	{0x7A, 0x52}: '槢',
+	{0x7A, 0x53}: '樰',
+	{0x7A, 0x54}: '橫',
+	{0x7A, 0x55}: '橆',
+	{0x7A, 0x56}: '橳',
+	{0x7A, 0x57}: '橾',
+	{0x7A, 0x58}: '櫢',
+	{0x7A, 0x59}: '櫤',
+	{0x7A, 0x5A}: '毖',
+	{0x7A, 0x5B}: '氿',
+	{0x7A, 0x5C}: '汜',
+	{0x7A, 0x5D}: '沆',
+	{0x7A, 0x5E}: '汯',
+	{0x7A, 0x5F}: '泚',
+	{0x7A, 0x60}: '洄',
+	{0x7A, 0x61}: '涇',
+	{0x7A, 0x62}: '浯',
+	{0x7A, 0x63}: '涖',
+	{0x7A, 0x64}: '涬',
+	{0x7A, 0x65}: '淏',
+	{0x7A, 0x66}: '淸',
+	{0x7A, 0x67}: '淲',
+	{0x7A, 0x68}: '淼',
+	{0x7A, 0x69}: '渹',
+	{0x7A, 0x6A}: '湜',
+	{0x7A, 0x6B}: '渧',
+	{0x7A, 0x6C}: '渼',
+	{0x7A, 0x6D}: '溿',
+	{0x7A, 0x6E}: '澈',
+	{0x7A, 0x6F}: '澵',
+	{0x7A, 0x70}: '濵',
+	{0x7A, 0x71}: '瀅',
+	{0x7A, 0x72}: '瀇',
+	{0x7A, 0x73}: '瀨',
+	{0x7A, 0x74}: '炅',
+	{0x7A, 0x75}: '炫',
+	{0x7A, 0x76}: '焏',
+	{0x7A, 0x77}: '焄',
+	{0x7A, 0x78}: '煜',
+	{0x7A, 0x79}: '煆',
+	{0x7A, 0x7A}: '煇',
+	{0x7A, 0x7B}: '凞',
+	{0x7A, 0x7C}: '燁',
+	{0x7A, 0x7D}: '燾',
+	{0x7A, 0x7E}: '犱',
+	{0x7B, 0x21}: '犾',
+	{0x7B, 0x22}: '猤',
+	{0x7B, 0x23}: '猪',
+	{0x7B, 0x24}: '獷',
+	{0x7B, 0x25}: '玽',
+	{0x7B, 0x26}: '珉',
+	{0x7B, 0x27}: '珖',
+	{0x7B, 0x28}: '珣',
+	{0x7B, 0x29}: '珒',
+	{0x7B, 0x2A}: '琇',
+	{0x7B, 0x2B}: '珵',
+	{0x7B, 0x2C}: '琦',
+	{0x7B, 0x2D}: '琪',
+	{0x7B, 0x2E}: '琩',
+	{0x7B, 0x2F}: '琮',
+	{0x7B, 0x30}: '瑢',
+	{0x7B, 0x31}: '璉',
+	{0x7B, 0x32}: '璟',
+	{0x7B, 0x33}: '甁',
+	{0x7B, 0x34}: '畯',
+	{0x7B, 0x35}: '皂',
+	{0x7B, 0x36}: '皜',
+	{0x7B, 0x37}: '皞',
+	{0x7B, 0x38}: '皛',
+	{0x7B, 0x39}: '皦',
+	{0x7B, 0x3A}: '益',
+	{0x7B, 0x3B}: '睆',
+	{0x7B, 0x3C}: '劯',
+	{0x7B, 0x3D}: '砡',
+	{0x7B, 0x3E}: '硎',
+	{0x7B, 0x3F}: '硤',
+	{0x7B, 0x40}: '硺',
+	{0x7B, 0x41}: '礰',
+	{0x7B, 0x42}: '礼',
+	{0x7B, 0x43}: '神',
+	{0x7B, 0x44}: '祥',
+	{0x7B, 0x45}: '禔',
+	{0x7B, 0x46}: '福',
+	{0x7B, 0x47}: '禛',
+	{0x7B, 0x48}: '竑',
+	{0x7B, 0x49}: '竧',
+	{0x7B, 0x4A}: '靖',
+	{0x7B, 0x4B}: '竫',
+	{0x7B, 0x4C}: '箞',
+	{0x7B, 0x4D}: '精',
+	{0x7B, 0x4E}: '絈',
+	{0x7B, 0x4F}: '絜',
+	{0x7B, 0x50}: '綷',
+	{0x7B, 0x51}: '綠',
+	{0x7B, 0x52}: '緖',
+	{0x7B, 0x53}: '繒',
+	{0x7B, 0x54}: '罇',
+	{0x7B, 0x55}: '羡',
+	{0x7B, 0x56}: '羽',
+	{0x7B, 0x57}: '茁',
+	{0x7B, 0x58}: '荢',
+	{0x7B, 0x59}: '荿',
+	{0x7B, 0x5A}: '菇',
+	{0x7B, 0x5B}: '菶',
+	{0x7B, 0x5C}: '葈',
+	{0x7B, 0x5D}: '蒴',
+	{0x7B, 0x5E}: '蕓',
+	{0x7B, 0x5F}: '蕙',
+	{0x7B, 0x60}: '蕫',
+	{0x7B, 0x61}: '﨟',
+	{0x7B, 0x62}: '薰',
+	{0x7B, 0x63}: '蘒',
+	{0x7B, 0x64}: '﨡',
+	{0x7B, 0x65}: '蠇',
+	{0x7B, 0x66}: '裵',
+	{0x7B, 0x67}: '訒',
+	{0x7B, 0x68}: '訷',
+	{0x7B, 0x69}: '詹',
+	{0x7B, 0x6A}: '誧',
+	{0x7B, 0x6B}: '誾',
+	{0x7B, 0x6C}: '諟',
+	{0x7B, 0x6D}: '諸',
+	{0x7B, 0x6E}: '諶',
+	{0x7B, 0x6F}: '譓',
+	{0x7B, 0x70}: '譿',
+	{0x7B, 0x71}: '賰',
+	{0x7B, 0x72}: '賴',
+	{0x7B, 0x73}: '贒',
+	{0x7B, 0x74}: '赶',
+	{0x7B, 0x75}: '﨣',
+	{0x7B, 0x76}: '軏',
+	{0x7B, 0x77}: '﨤',
+	{0x7B, 0x78}: '逸',
+	{0x7B, 0x79}: '遧',
+	{0x7B, 0x7A}: '郞',
+	{0x7B, 0x7B}: '都',
+	{0x7B, 0x7C}: '鄕',
+	{0x7B, 0x7D}: '鄧',
+	{0x7B, 0x7E}: '釚',
+	{0x7C, 0x21}: '釗',
+	{0x7C, 0x22}: '釞',
+	{0x7C, 0x23}: '釭',
+	{0x7C, 0x24}: '釮',
+	{0x7C, 0x25}: '釤',
+	{0x7C, 0x26}: '釥',
+	{0x7C, 0x27}: '鈆',
+	{0x7C, 0x28}: '鈐',
+	{0x7C, 0x29}: '鈊',
+	{0x7C, 0x2A}: '鈺',
+	{0x7C, 0x2B}: '鉀',
+	{0x7C, 0x2C}: '鈼',
+	{0x7C, 0x2D}: '鉎',
+	{0x7C, 0x2E}: '鉙',
+	{0x7C, 0x2F}: '鉑',
+	{0x7C, 0x30}: '鈹',
+	{0x7C, 0x31}: '鉧',
+	{0x7C, 0x32}: '銧',
+	{0x7C, 0x33}: '鉷',
+	{0x7C, 0x34}: '鉸',
+	{0x7C, 0x35}: '鋧',
+	{0x7C, 0x36}: '鋗',
+	{0x7C, 0x37}: '鋙',
+	{0x7C, 0x38}: '鋐',
+	{0x7C, 0x39}: '﨧',
+	{0x7C, 0x3A}: '鋕',
+	{0x7C, 0x3B}: '鋠',
+	{0x7C, 0x3C}: '鋓',
+	{0x7C, 0x3D}: '錥',
+	{0x7C, 0x3E}: '錡',
+	{0x7C, 0x3F}: '鋻',
+	{0x7C, 0x40}: '﨨',
+	{0x7C, 0x41}: '錞',
+	{0x7C, 0x42}: '鋿',
+	{0x7C, 0x43}: '錝',
+	{0x7C, 0x44}: '錂',
+	{0x7C, 0x45}: '鍰',
+	{0x7C, 0x46}: '鍗',
+	{0x7C, 0x47}: '鎤',
+	{0x7C, 0x48}: '鏆',
+	{0x7C, 0x49}: '鏞',
+	{0x7C, 0x4A}: '鏸',
+	{0x7C, 0x4B}: '鐱',
+	{0x7C, 0x4C}: '鑅',
+	{0x7C, 0x4D}: '鑈',
+	{0x7C, 0x4E}: '閒',
+	{0x7C, 0x4F}: '隆',
+	{0x7C, 0x50}: '﨩',
+	{0x7C, 0x51}: '隝',
+	{0x7C, 0x52}: '隯',
+	{0x7C, 0x53}: '霳',
+	{0x7C, 0x54}: '霻',
+	{0x7C, 0x55}: '靃',
+	{0x7C, 0x56}: '靍',
+	{0x7C, 0x57}: '靏',
+	{0x7C, 0x58}: '靑',
+	{0x7C, 0x59}: '靕',
+	{0x7C, 0x5A}: '顗',
+	{0x7C, 0x5B}: '顥',
+	{0x7C, 0x5C}: '飯',
+	{0x7C, 0x5D}: '飼',
+	{0x7C, 0x5E}: '餧',
+	{0x7C, 0x5F}: '館',
+	{0x7C, 0x60}: '馞',
+	{0x7C, 0x61}: '驎',
+	{0x7C, 0x62}: '髙',
+	{0x7C, 0x63}: '髜',
+	{0x7C, 0x64}: '魵',
+	{0x7C, 0x65}: '魲',
+	{0x7C, 0x66}: '鮏',
+	{0x7C, 0x67}: '鮱',
+	{0x7C, 0x68}: '鮻',
+	{0x7C, 0x69}: '鰀',
+	{0x7C, 0x6A}: '鵰',
+	{0x7C, 0x6B}: '鵫',
+	{0x7C, 0x6C}: '鶴',
+	{0x7C, 0x6D}: '鸙',
+	{0x7C, 0x6E}: '黑',
+	{0x7C, 0x7B}: '￢',
+	{0x7C, 0x7C}: '￤',
+	{0x7C, 0x7D}: '＇',
+	{0x7C, 0x7E}: '＂',
+}
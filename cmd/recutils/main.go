@@ -0,0 +1,61 @@
+// Command recutils extracts ARIB closed caption subtitles from an
+// MPEG2-TS file and writes them in a subtitle format of choice.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/haru8/eagletmt-recutils/subwriter"
+	"github.com/haru8/eagletmt-recutils/tscaption"
+)
+
+func main() {
+	format := flag.String("f", "ass", "output format: ass, srt, vtt, json")
+	outPath := flag.String("o", "", "output file path (default: stdout)")
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		fmt.Fprintf(os.Stderr, "usage: %s [-f ass|srt|vtt|json] [-o PATH] MPEG2-TS-FILE\n", os.Args[0])
+		os.Exit(1)
+	}
+
+	writer, ok := subwriter.ByFormat(*format)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown output format: %s\n", *format)
+		os.Exit(1)
+	}
+
+	var fin io.Reader
+	if flag.Arg(0) == "-" {
+		fin = os.Stdin
+	} else {
+		f, err := os.Open(flag.Arg(0))
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		fin = f
+	}
+
+	var fout io.Writer = os.Stdout
+	if *outPath != "" {
+		f, err := os.Create(*outPath)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		fout = f
+	}
+
+	analyzer := tscaption.NewAnalyzer(tscaption.Options{})
+	analyzer.SetOutput(fout)
+	if err := analyzer.Process(fin, writer); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,30 @@
+package subwriter
+
+import (
+	"fmt"
+	"io"
+	"time"
+)
+
+// ASSWriter renders Advanced SubStation Alpha, the format this tool
+// originally hard-coded. Cue text is passed straight through, since it
+// already carries ASS inline override tags.
+type ASSWriter struct{}
+
+func (ASSWriter) WritePrelude(w io.Writer) {
+	fmt.Fprintln(w, "[Script Info]")
+	fmt.Fprintln(w, "ScriptType: v4.00+")
+	fmt.Fprintln(w, "Collisions: Normal")
+	fmt.Fprintln(w, "ScaledBorderAndShadow: yes")
+	fmt.Fprintln(w, "Timer: 100.0000")
+	fmt.Fprintln(w, "\n[Events]")
+}
+
+func (ASSWriter) WriteCue(w io.Writer, start, end time.Time, centiStart, centiEnd int, text string) {
+	fmt.Fprintf(w, "Dialogue: 0,%02d:%02d:%02d.%02d,%02d:%02d:%02d.%02d,Default,,,,,,%s\n",
+		start.Hour(), start.Minute(), start.Second(), centiStart,
+		end.Hour(), end.Minute(), end.Second(), centiEnd,
+		text)
+}
+
+func (ASSWriter) WriteEpilogue(w io.Writer) {}
@@ -0,0 +1,59 @@
+package subwriter
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// JSONWriter renders line-delimited JSON, one object per cue. PID
+// identifies the caption elementary stream the cues came from, and is
+// set by the caller before the first WriteCue call.
+type JSONWriter struct {
+	PID int
+}
+
+type jsonCue struct {
+	StartMs int64       `json:"start_ms"`
+	EndMs   int64       `json:"end_ms"`
+	Text    string      `json:"text"`
+	PID     int         `json:"pid"`
+	Style   []jsonStyle `json:"style,omitempty"`
+}
+
+type jsonStyle struct {
+	Text  string `json:"text"`
+	Color string `json:"color,omitempty"`
+}
+
+func (JSONWriter) WritePrelude(w io.Writer) {}
+
+func (j JSONWriter) WriteCue(w io.Writer, start, end time.Time, centiStart, centiEnd int, text string) {
+	plain, spans := parseASSMarkup(text)
+	cue := jsonCue{
+		StartMs: start.Unix()*1000 + int64(centiStart)*10,
+		EndMs:   end.Unix()*1000 + int64(centiEnd)*10,
+		Text:    strings.ReplaceAll(plain, "\\N", "\n"),
+		PID:     j.PID,
+	}
+	for _, span := range spans {
+		if span.Color == "" {
+			continue
+		}
+		cue.Style = append(cue.Style, jsonStyle{
+			Text:  strings.ReplaceAll(span.Text, "\\N", "\n"),
+			Color: span.Color,
+		})
+	}
+	b, err := json.Marshal(cue)
+	if err != nil {
+		fmt.Fprintf(w, "{}\n")
+		return
+	}
+	w.Write(b)
+	fmt.Fprintln(w)
+}
+
+func (JSONWriter) WriteEpilogue(w io.Writer) {}
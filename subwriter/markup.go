@@ -0,0 +1,55 @@
+package subwriter
+
+import "strings"
+
+// styledSpan is a contiguous run of text together with the ASS
+// override state active while it is displayed.
+type styledSpan struct {
+	Text  string
+	Color string // bare "bbggrr" hex, empty for the default color
+}
+
+// parseASSMarkup splits text produced by aribb24.DecodeWithStyle (an
+// ASS-flavored string with "{\c&Hbbggrr&}"/"{\fs..}" inline override
+// tags) into plain text and a parallel set of styled spans, for
+// formats that need to know where styling changes without themselves
+// understanding ASS override syntax.
+func parseASSMarkup(text string) (plain string, spans []styledSpan) {
+	var b strings.Builder
+	color := ""
+	spanStart := 0
+	flush := func(end int) {
+		if end > spanStart {
+			spans = append(spans, styledSpan{Text: text[spanStart:end], Color: color})
+		}
+	}
+
+	i := 0
+	for i < len(text) {
+		if text[i] == '{' {
+			end := strings.IndexByte(text[i:], '}')
+			if end == -1 {
+				break
+			}
+			tag := text[i+1 : i+end]
+			flush(i)
+			if strings.HasPrefix(tag, "\\c&H") && strings.HasSuffix(tag, "&") {
+				color = strings.TrimSuffix(strings.TrimPrefix(tag, "\\c&H"), "&")
+			}
+			i += end + 1
+			spanStart = i
+			continue
+		}
+		b.WriteByte(text[i])
+		i++
+	}
+	flush(len(text))
+	return b.String(), spans
+}
+
+// stripASSMarkup discards ASS override tags and turns each "\N"
+// line-break escape into a real newline, returning bare text.
+func stripASSMarkup(text string) string {
+	plain, _ := parseASSMarkup(text)
+	return strings.ReplaceAll(plain, "\\N", "\n")
+}
@@ -0,0 +1,30 @@
+package subwriter
+
+import (
+	"fmt"
+	"io"
+	"time"
+)
+
+// SRTWriter renders SubRip (.srt), which has no prelude/epilogue and
+// numbers cues sequentially starting at 1.
+type SRTWriter struct {
+	n int
+}
+
+func (*SRTWriter) WritePrelude(w io.Writer) {}
+
+func (s *SRTWriter) WriteCue(w io.Writer, start, end time.Time, centiStart, centiEnd int, text string) {
+	s.n++
+	fmt.Fprintf(w, "%d\n%s --> %s\n%s\n\n",
+		s.n,
+		srtTimestamp(start, centiStart),
+		srtTimestamp(end, centiEnd),
+		stripASSMarkup(text))
+}
+
+func (*SRTWriter) WriteEpilogue(w io.Writer) {}
+
+func srtTimestamp(t time.Time, centi int) string {
+	return fmt.Sprintf("%02d:%02d:%02d,%03d", t.Hour(), t.Minute(), t.Second(), centi*10)
+}
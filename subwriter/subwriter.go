@@ -0,0 +1,40 @@
+// Package subwriter renders decoded caption cues into various
+// subtitle file formats, so callers can pick an output format without
+// the caller needing to know about its wire format details.
+package subwriter
+
+import (
+	"io"
+	"time"
+)
+
+// Writer renders a subtitle track as a prelude, a sequence of cues,
+// and an epilogue. Implementations may assume WritePrelude is called
+// exactly once before any WriteCue call, and WriteEpilogue exactly
+// once after the last one.
+//
+// text may contain ASS inline override tags (e.g. "{\c&Hbbggrr&}")
+// as produced by aribb24.DecodeWithStyle; implementations that target
+// a format without inline styling should strip or translate them.
+type Writer interface {
+	WritePrelude(w io.Writer)
+	WriteCue(w io.Writer, start, end time.Time, centiStart, centiEnd int, text string)
+	WriteEpilogue(w io.Writer)
+}
+
+// ByFormat returns the Writer registered for the given -f flag value,
+// and whether one was found.
+func ByFormat(format string) (Writer, bool) {
+	switch format {
+	case "ass", "":
+		return ASSWriter{}, true
+	case "srt":
+		return &SRTWriter{}, true
+	case "vtt":
+		return VTTWriter{}, true
+	case "json":
+		return &JSONWriter{}, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,52 @@
+package subwriter
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// VTTWriter renders WebVTT, translating the ARIB foreground colors
+// aribb24 encodes as ASS "{\c&Hbbggrr&}" overrides into WebVTT's
+// "<c.colorname>" voice span tags where the color is a known one.
+type VTTWriter struct{}
+
+// vttColorNames maps the bare "bbggrr" hex aribb24 emits for the eight
+// C1 foreground color controls to the WebVTT color class names real
+// players recognize.
+var vttColorNames = map[string]string{
+	"000000": "black",
+	"0000FF": "red",
+	"00FF00": "green",
+	"00FFFF": "yellow",
+	"FF0000": "blue",
+	"FF00FF": "magenta",
+	"FFFF00": "cyan",
+	"FFFFFF": "white",
+}
+
+func (VTTWriter) WritePrelude(w io.Writer) {
+	fmt.Fprintln(w, "WEBVTT")
+	fmt.Fprintln(w)
+}
+
+func (VTTWriter) WriteCue(w io.Writer, start, end time.Time, centiStart, centiEnd int, text string) {
+	_, spans := parseASSMarkup(text)
+	var b strings.Builder
+	for _, span := range spans {
+		line := strings.ReplaceAll(span.Text, "\\N", "\n")
+		if name, ok := vttColorNames[span.Color]; ok && span.Color != "" {
+			fmt.Fprintf(&b, "<c.%s>%s</c>", name, line)
+		} else {
+			b.WriteString(line)
+		}
+	}
+	fmt.Fprintf(w, "%s --> %s\n%s\n\n", vttTimestamp(start, centiStart), vttTimestamp(end, centiEnd), b.String())
+}
+
+func (VTTWriter) WriteEpilogue(w io.Writer) {}
+
+func vttTimestamp(t time.Time, centi int) string {
+	return fmt.Sprintf("%02d:%02d:%02d.%03d", t.Hour(), t.Minute(), t.Second(), centi*10)
+}
@@ -0,0 +1,96 @@
+package tscaption
+
+import "time"
+
+import "github.com/haru8/eagletmt-recutils/aribb24"
+
+func (a *Analyzer) dumpCaption(payload []byte) {
+	if len(payload) < 9 {
+		a.log.Printf("%s: short PES packet for caption data\n", ErrShortPacket)
+		return
+	}
+	PES_header_data_length := payload[8]
+	if len(payload) < int(11+PES_header_data_length)+1 {
+		a.log.Printf("%s: short PES packet for caption data\n", ErrShortPacket)
+		return
+	}
+	PES_data_packet_header_length := payload[11+PES_header_data_length] & 0x0F
+	p := payload[12+PES_header_data_length+PES_data_packet_header_length:]
+	if len(p) < 6 {
+		a.log.Printf("%s: short caption data\n", ErrShortPacket)
+		return
+	}
+
+	// [B24] Table 9-1 (p184)
+	data_group_id := (p[0] & 0xFC) >> 2
+	if data_group_id == 0x00 || data_group_id == 0x20 {
+		// [B24] Table 9-3 (p186)
+		// caption_management_data
+		num_languages := p[6]
+		p = p[7+num_languages*5:]
+	} else {
+		// caption_data
+		p = p[6:]
+	}
+	if len(p) < 3 {
+		a.log.Printf("%s: short caption data\n", ErrShortPacket)
+		return
+	}
+	// [B24] Table 9-3 (p186)
+	data_unit_loop_length := (int(p[0]) << 16) | (int(p[1]) << 8) | int(p[2])
+	index := 0
+	for index < data_unit_loop_length && index+8 <= len(p) {
+		q := p[index:]
+		data_unit_parameter := q[4]
+		data_unit_size := (int(q[5]) << 16) | (int(q[6]) << 8) | int(q[7])
+		if index+8+data_unit_size > len(p) {
+			a.log.Printf("%s: data unit exceeds caption data\n", ErrShortPacket)
+			return
+		}
+		if data_unit_parameter == 0x20 {
+			if len(a.previousSubtitle) != 0 && !(isBlank(a.previousSubtitle) && a.previousIsBlank) {
+				prevTimeCenti := a.previousTimestamp.centitime() + a.clockOffset
+				curTimeCenti := a.currentTimestamp.centitime() + a.clockOffset
+				prevTime := prevTimeCenti / 100
+				curTime := curTimeCenti / 100
+				prevCenti := prevTimeCenti % 100
+				curCenti := curTimeCenti % 100
+				prev := time.Unix(prevTime, 0)
+				cur := time.Unix(curTime, 0)
+				if !a.preludePrinted {
+					a.writer.WritePrelude(a.output)
+					a.preludePrinted = true
+				}
+				a.writer.WriteCue(a.output, prev, cur, int(prevCenti), int(curCenti), a.previousSubtitle)
+			}
+			text, shiftCentiseconds := a.decodeCprofile(q[8 : 8+data_unit_size])
+			a.previousIsBlank = isBlank(a.previousSubtitle)
+			a.previousSubtitle = text
+			a.previousTimestamp = a.currentTimestamp.addCentiseconds(shiftCentiseconds)
+		}
+		index += 5 + data_unit_size
+	}
+}
+
+// decodeCprofile decodes a caption statement and returns its text along
+// with any TIME control shift it carried, in hundredths of a second.
+// The shift applies only to this statement's own display timestamp,
+// not to a.clockOffset, which is reserved for PCR/JST synchronization
+// shared across the whole stream.
+func (a *Analyzer) decodeCprofile(str []byte) (string, int) {
+	result, err := aribb24.DecodeWithStyle(str)
+	if err != nil {
+		a.log.Printf("Failed to decode caption: %s\n", err)
+		return "", 0
+	}
+	return result.Text, result.TimeShiftCentiseconds
+}
+
+func isBlank(str string) bool {
+	for _, c := range str {
+		if c != ' ' {
+			return false
+		}
+	}
+	return true
+}
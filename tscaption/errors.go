@@ -0,0 +1,22 @@
+package tscaption
+
+import "errors"
+
+// Sentinel errors describing the recoverable failures this package's
+// parsing can hit. They are passed to the configured Logger rather
+// than returned from Process, except where noted.
+var (
+	// ErrSyncLost means a TS packet did not start with the 0x47 sync
+	// byte and was skipped.
+	ErrSyncLost = errors.New("tscaption: sync_byte failed")
+	// ErrShortPacket means a section or PES packet ended before a
+	// field this package needed to read from it.
+	ErrShortPacket = errors.New("tscaption: packet too short")
+	// ErrInvalidPMT means a Program Map Table section failed to
+	// parse, for example due to an unexpected table_id or a
+	// section_length that didn't fit the available data.
+	ErrInvalidPMT = errors.New("tscaption: invalid PMT section")
+	// ErrInvalidTOT means a Time Offset Table section failed to
+	// parse, for example due to an unexpected table_id.
+	ErrInvalidTOT = errors.New("tscaption: invalid TOT section")
+)
@@ -0,0 +1,21 @@
+package tscaption
+
+import (
+	"fmt"
+	"os"
+)
+
+// Logger receives diagnostics about recoverable errors. It matches the
+// subset of log.Logger's signature this package needs, so callers can
+// pass a *log.Logger directly.
+type Logger interface {
+	Printf(format string, args ...interface{})
+}
+
+// stderrLogger is the default Logger, matching this tool's original
+// behavior of writing diagnostics straight to stderr.
+type stderrLogger struct{}
+
+func (stderrLogger) Printf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format, args...)
+}
@@ -0,0 +1,131 @@
+package tscaption
+
+import (
+	"github.com/haru8/eagletmt-recutils/subwriter"
+	"github.com/haru8/eagletmt-recutils/tsreader"
+)
+
+func (a *Analyzer) analyzePacket(pkt tsreader.Packet) {
+	packet := pkt.Data
+	if packet[0] != 0x47 {
+		a.log.Printf("%s\n", ErrSyncLost)
+		return
+	}
+
+	if pkt.ArrivalTimestamp != nil && a.useArrivalClock() {
+		a.currentTimestamp = extractArrivalClock(pkt.ArrivalTimestamp)
+	}
+
+	payload_unit_start_indicator := (packet[1] & 0x40) != 0
+	pid := int(packet[1]&0x1f)<<8 | int(packet[2])
+	hasAdaptation := (packet[3] & 0x20) != 0
+	hasPayload := (packet[3] & 0x10) != 0
+	p := packet[4:]
+
+	if hasAdaptation {
+		if len(p) == 0 {
+			a.log.Printf("%s: short adaptation field\n", ErrShortPacket)
+			return
+		}
+		// [ISO] 2.4.3.4
+		// Table 2-6
+		adaptation_field_length := p[0]
+		p = p[1:]
+		if len(p) == 0 {
+			a.log.Printf("%s: short adaptation field\n", ErrShortPacket)
+			return
+		}
+		pcr_flag := (p[0] & 0x10) != 0
+		if pcr_flag && pid == a.pcrPid && a.opts.ClockSource != ClockArrival {
+			if clock, err := extractPcr(p); err != nil {
+				a.log.Printf("%s\n", err)
+			} else {
+				a.currentTimestamp = clock
+			}
+		}
+		if int(adaptation_field_length) > len(p) {
+			a.log.Printf("%s: adaptation field longer than packet\n", ErrShortPacket)
+			return
+		}
+		p = p[adaptation_field_length:]
+	}
+
+	if !hasPayload {
+		return
+	}
+
+	switch {
+	case pid == 0:
+		if len(a.pmtPids) == 0 {
+			if len(p) == 0 {
+				return
+			}
+			pmtPids, err := extractPmtPids(p[1:], a.opts.ProgramNumber)
+			if err != nil {
+				a.log.Printf("%s\n", err)
+				return
+			}
+			a.pmtPids = pmtPids
+			a.log.Printf("Found %d pids: %v\n", len(a.pmtPids), a.pmtPids)
+		}
+	case a.pmtPids != nil && a.pmtPids[pid]:
+		if a.captionPid != -1 {
+			return
+		}
+		continuity_counter := packet[3] & 0x0F
+		section, ok := a.reassemble(pid, continuity_counter, payload_unit_start_indicator, p, psiSectionLength)
+		if !ok {
+			return
+		}
+		pcrPid, err := extractPcrPid(section[1:])
+		if err != nil {
+			a.log.Printf("%s\n", err)
+			return
+		}
+		captionPid, err := extractCaptionPid(section[1:], a.opts.componentTag())
+		if err != nil {
+			a.log.Printf("%s\n", err)
+			return
+		}
+		if captionPid != -1 {
+			a.log.Printf("caption pid = %d, PCR_PID = %d\n", captionPid, pcrPid)
+			a.pcrPid = pcrPid
+			a.captionPid = captionPid
+			if jsonWriter, ok := a.writer.(*subwriter.JSONWriter); ok {
+				jsonWriter.PID = captionPid
+			}
+		}
+	case pid == 0x0014:
+		// Time Offset Table
+		// [B10] 5.2.9
+		if len(p) == 0 {
+			return
+		}
+		t, err := extractJstTime(p[1:])
+		if err != nil {
+			a.log.Printf("%s\n", err)
+			return
+		}
+		if t != 0 {
+			a.clockOffset = t*100 - a.currentTimestamp.centitime()
+		}
+	case pid == a.captionPid:
+		continuity_counter := packet[3] & 0x0F
+		if pes, ok := a.reassemble(pid, continuity_counter, payload_unit_start_indicator, p, pesPacketLength); ok {
+			a.dumpCaption(pes)
+		}
+	}
+}
+
+// useArrivalClock reports whether the arrival timestamp fallback
+// should be consulted right now, per the configured ClockSource.
+func (a *Analyzer) useArrivalClock() bool {
+	switch a.opts.ClockSource {
+	case ClockArrival:
+		return true
+	case ClockPCR:
+		return false
+	default: // ClockAuto
+		return a.pcrPid == -1
+	}
+}
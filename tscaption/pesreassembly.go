@@ -0,0 +1,95 @@
+package tscaption
+
+// pesBuffer accumulates the payload of a PES packet or PSI section
+// that spans more than one TS packet for a single PID.
+type pesBuffer struct {
+	data       []byte
+	wanted     int // total bytes needed to complete the unit, 0 if unknown
+	continuity int // continuity_counter of the last packet folded in
+}
+
+// reassemble folds a TS packet's payload into the per-pid reassembly
+// buffer and reports whether it now holds a complete unit. lengthFunc
+// computes the total unit length (header included) from however much
+// of the unit's own header has accumulated so far; it returns -1 if
+// not enough bytes have arrived yet to know, and 0 if the unit has no
+// declared length and should instead be flushed on the next unit start.
+//
+// On a continuity_counter discontinuity the buffer is discarded, since
+// [ISO] 2.4.3.3 guarantees only that the counter increments by one
+// between TS packets carrying the same PID's payload.
+func (a *Analyzer) reassemble(pid int, continuityCounter byte, start bool, payload []byte, lengthFunc func([]byte) int) ([]byte, bool) {
+	if a.pesBuffers == nil {
+		a.pesBuffers = make(map[int]*pesBuffer)
+	}
+
+	if start {
+		var complete []byte
+		if old := a.pesBuffers[pid]; old != nil && old.wanted == 0 && len(old.data) > 0 {
+			complete = old.data
+		}
+		buf := &pesBuffer{data: append([]byte(nil), payload...), continuity: int(continuityCounter)}
+		buf.wanted = lengthFunc(buf.data)
+		if buf.wanted < 0 {
+			buf.wanted = 0
+		}
+		a.pesBuffers[pid] = buf
+		if complete != nil {
+			return complete, true
+		}
+	} else {
+		buf := a.pesBuffers[pid]
+		if buf == nil {
+			return nil, false
+		}
+		if int(continuityCounter) != (buf.continuity+1)&0x0F {
+			a.log.Printf("pid %d: continuity discontinuity, discarding reassembly buffer\n", pid)
+			delete(a.pesBuffers, pid)
+			return nil, false
+		}
+		buf.continuity = int(continuityCounter)
+		buf.data = append(buf.data, payload...)
+		if buf.wanted == 0 {
+			buf.wanted = lengthFunc(buf.data)
+			if buf.wanted < 0 {
+				buf.wanted = 0
+			}
+		}
+	}
+
+	buf := a.pesBuffers[pid]
+	if buf.wanted > 0 && len(buf.data) >= buf.wanted {
+		delete(a.pesBuffers, pid)
+		return buf.data[:buf.wanted], true
+	}
+	return nil, false
+}
+
+// pesPacketLength computes the total size of a PES packet (header
+// included) from PES_packet_length at bytes 4-5. [ISO] 2.4.3.6.
+func pesPacketLength(data []byte) int {
+	if len(data) < 6 {
+		return -1
+	}
+	length := int(data[4])<<8 | int(data[5])
+	if length == 0 {
+		// Unbounded (common for video streams); flush on next start.
+		return 0
+	}
+	return 6 + length
+}
+
+// psiSectionLength computes the total size of a PSI section (pointer
+// field and section header included) from section_length. [ISO] 2.4.4.3.
+func psiSectionLength(data []byte) int {
+	if len(data) < 1 {
+		return -1
+	}
+	pointerField := int(data[0])
+	headerStart := 1 + pointerField
+	if len(data) < headerStart+3 {
+		return -1
+	}
+	sectionLength := int(data[headerStart+1]&0x0F)<<8 | int(data[headerStart+2])
+	return headerStart + 3 + sectionLength
+}
@@ -0,0 +1,155 @@
+package tscaption
+
+import (
+	"fmt"
+	"time"
+)
+
+/*
+[B10]: ARIB-STD B10
+[ISO]: ISO/IEC 13818-1
+*/
+
+// extractPmtPids parses a PAT section and returns the PMT PIDs of its
+// programs, restricted to programNumber when it is non-zero.
+// [ISO] 2.4.4.3 Table 2-25.
+func extractPmtPids(payload []byte, programNumber int) (map[int]bool, error) {
+	if len(payload) < 3 {
+		return nil, ErrShortPacket
+	}
+	table_id := payload[0]
+	pids := make(map[int]bool)
+	if table_id != 0x00 {
+		return pids, nil
+	}
+	section_length := int(payload[1]&0x0F)<<8 | int(payload[2])
+	index := 8
+	for index < 3+section_length-4 {
+		if index+4 > len(payload) {
+			return nil, ErrInvalidPMT
+		}
+		program_number := int(payload[index+0])<<8 | int(payload[index+1])
+		if program_number != 0 && (programNumber == 0 || program_number == programNumber) {
+			program_map_PID := int(payload[index+2]&0x1F)<<8 | int(payload[index+3])
+			pids[program_map_PID] = true
+		}
+		index += 4
+	}
+	return pids, nil
+}
+
+func extractPcrPid(payload []byte) (int, error) {
+	if len(payload) < 10 {
+		return 0, ErrShortPacket
+	}
+	return (int(payload[8]&0x1f) << 8) | int(payload[9]), nil
+}
+
+// extractCaptionPid finds the elementary PID of the caption stream
+// tagged with componentTag in a PMT section. [ISO] 2.4.4.8 Table 2-28.
+func extractCaptionPid(payload []byte, componentTag byte) (int, error) {
+	if len(payload) < 12 {
+		return -1, ErrShortPacket
+	}
+	table_id := payload[0]
+	if table_id != 0x02 {
+		return -1, ErrInvalidPMT
+	}
+	section_length := int(payload[1]&0x0F)<<8 | int(payload[2])
+	if section_length >= len(payload) {
+		return -1, ErrInvalidPMT
+	}
+
+	program_info_length := int(payload[10]&0x0F)<<8 | int(payload[11])
+	index := 12 + program_info_length
+
+	for index < 3+section_length-4 {
+		if index+5 > len(payload) {
+			return -1, ErrInvalidPMT
+		}
+		stream_type := payload[index+0]
+		ES_info_length := int(payload[index+3]&0xF)<<8 | int(payload[index+4])
+		if stream_type == 0x06 {
+			elementary_PID := int(payload[index+1]&0x1F)<<8 | int(payload[index+2])
+			subIndex := index + 5
+			for subIndex < index+ES_info_length {
+				// [ISO] 2.6 Program and program element descriptors
+				if subIndex+2 > len(payload) {
+					return -1, ErrInvalidPMT
+				}
+				descriptor_tag := payload[subIndex+0]
+				descriptor_length := int(payload[subIndex+1])
+				if descriptor_tag == 0x52 {
+					// [B10] 6.2.16 Stream identifier descriptor
+					// 表 6-28
+					if subIndex+2 >= len(payload) {
+						return -1, ErrInvalidPMT
+					}
+					if payload[subIndex+2] == componentTag {
+						return elementary_PID, nil
+					}
+				}
+				subIndex += 2 + descriptor_length
+			}
+		}
+		index += 5 + ES_info_length
+	}
+	return -1, nil
+}
+
+func extractPcr(payload []byte) (SystemClock, error) {
+	if len(payload) < 7 {
+		return 0, ErrShortPacket
+	}
+	pcr_base := (int64(payload[1]) << 25) |
+		(int64(payload[2]) << 17) |
+		(int64(payload[3]) << 9) |
+		(int64(payload[4]) << 1) |
+		(int64(payload[5]&0x80) >> 7)
+	pcr_ext := (int64(payload[5] & 0x01)) | int64(payload[6])
+	// [ISO] 2.4.2.2
+	return SystemClock(pcr_base*300 + pcr_ext), nil
+}
+
+// extractArrivalClock converts a 4-byte M2TS/BDAV TP_Extra_Header
+// arrival timestamp (a 30-bit counter running at 1/300th the 27MHz
+// system clock) into the same units as extractPcr.
+func extractArrivalClock(ts []byte) SystemClock {
+	v := (uint32(ts[0]&0x3F) << 24) | (uint32(ts[1]) << 16) | (uint32(ts[2]) << 8) | uint32(ts[3])
+	return SystemClock(int64(v) * 300)
+}
+
+func extractJstTime(payload []byte) (int64, error) {
+	if len(payload) < 8 {
+		return 0, ErrShortPacket
+	}
+	if payload[0] != 0x73 {
+		return 0, ErrInvalidTOT
+	}
+
+	// [B10] Appendix C
+	MJD := (int(payload[3]) << 8) | int(payload[4])
+	y := int((float64(MJD) - 15078.2) / 365.25)
+	m := int((float64(MJD) - 14956.1 - float64(int(float64(y)*365.25))) / 30.6001)
+	k := 0
+	if m == 14 || m == 15 {
+		k = 1
+	}
+	year := y + k + 1900
+	month := m - 2 - k*12
+	day := MJD - 14956 - int(float64(y)*365.25) - int(float64(m)*30.6001)
+	hour := decodeBcd(payload[5])
+	minute := decodeBcd(payload[6])
+	second := decodeBcd(payload[7])
+
+	str := fmt.Sprintf("%d-%02d-%02dT%02d:%02d:%02d+09:00", year, month, day, hour, minute, second)
+	t, err := time.Parse(time.RFC3339, str)
+	if err != nil {
+		return 0, ErrInvalidTOT
+	}
+	return t.Unix(), nil
+}
+
+func decodeBcd(n byte) int {
+	return (int(n)>>4)*10 + int(n&0x0f)
+}
@@ -0,0 +1,155 @@
+// Package tscaption extracts ARIB closed caption subtitles from an
+// MPEG2-TS stream and renders them through a subwriter.Writer. It is
+// the library that backs the cmd/recutils CLI.
+package tscaption
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/haru8/eagletmt-recutils/subwriter"
+	"github.com/haru8/eagletmt-recutils/tsreader"
+)
+
+// SubtitleWriter renders the cues an Analyzer produces. It is an alias
+// for subwriter.Writer so callers of this package don't also need to
+// import subwriter just to spell the type.
+type SubtitleWriter = subwriter.Writer
+
+// ClockSource selects which clock Analyzer trusts to time captions.
+type ClockSource int
+
+const (
+	// ClockAuto prefers PCR once a PCR PID is known, falling back to
+	// the M2TS/BDAV arrival timestamp until then. This matches the
+	// tool's original behavior.
+	ClockAuto ClockSource = iota
+	// ClockPCR uses only the PCR_PID's program clock reference, never
+	// falling back to arrival timestamps.
+	ClockPCR
+	// ClockArrival always uses the M2TS/BDAV arrival timestamp, even
+	// once a PCR PID is known.
+	ClockArrival
+)
+
+// Options configures an Analyzer.
+type Options struct {
+	// ProgramNumber selects which program's PMT to follow when the
+	// stream carries more than one. 0 (the default) keeps the
+	// original behavior of reading the PMT of every non-zero program
+	// in the PAT and following the first one found to carry the
+	// selected caption stream.
+	ProgramNumber int
+	// ComponentTag selects which caption elementary stream to follow,
+	// via its [B10] 6.2.16 stream identifier descriptor. 0 (the
+	// default) selects 0x87, the primary caption stream; pass 0x88 to
+	// follow the second stream of a bilingual broadcast.
+	ComponentTag byte
+	// ClockSource selects the clock used to time captions.
+	ClockSource ClockSource
+	// Logger receives diagnostics about recoverable errors (bad sync,
+	// malformed PMT sections, and the like). A nil Logger logs to
+	// stderr, matching the tool's original behavior.
+	Logger Logger
+}
+
+func (o Options) componentTag() byte {
+	if o.ComponentTag == 0 {
+		return 0x87
+	}
+	return o.ComponentTag
+}
+
+// Analyzer walks an MPEG2-TS stream and extracts ARIB captions from
+// it. Create one with NewAnalyzer and call Process once per stream.
+type Analyzer struct {
+	opts Options
+	log  Logger
+
+	pmtPids           map[int]bool
+	pcrPid            int
+	captionPid        int
+	currentTimestamp  SystemClock
+	clockOffset       int64
+	previousSubtitle  string
+	previousIsBlank   bool
+	previousTimestamp SystemClock
+	preludePrinted    bool
+	pesBuffers        map[int]*pesBuffer
+
+	writer SubtitleWriter
+	output io.Writer
+}
+
+// NewAnalyzer creates an Analyzer with the given options.
+func NewAnalyzer(opts Options) *Analyzer {
+	logger := opts.Logger
+	if logger == nil {
+		logger = stderrLogger{}
+	}
+	return &Analyzer{
+		opts:       opts,
+		log:        logger,
+		pcrPid:     -1,
+		captionPid: -1,
+	}
+}
+
+// Process reads r as an MPEG2-TS stream, possibly in the 188/192/204
+// byte packet variants tsreader understands, and writes the captions
+// it finds to w. Recoverable errors (lost sync, malformed PMT or TOT
+// sections) are logged and skipped. Process returns an error when
+// reading r fails or no TS sync pattern is found at the start of r;
+// errors writing the output are not reported, since the
+// SubtitleWriter methods return none.
+func (a *Analyzer) Process(r io.Reader, w SubtitleWriter) error {
+	a.writer = w
+	if a.output == nil {
+		a.output = os.Stdout
+	}
+
+	reader, err := tsreader.NewReader(r)
+	if err != nil {
+		return fmt.Errorf("tscaption: %w", err)
+	}
+
+	for {
+		packet, err := reader.NextPacket()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		a.analyzePacket(packet)
+	}
+
+	if a.preludePrinted {
+		a.writer.WriteEpilogue(a.output)
+	}
+	if reader.ResyncCount > 0 {
+		a.log.Printf("tsreader: resynchronized %d time(s)\n", reader.ResyncCount)
+	}
+	return nil
+}
+
+// SetOutput overrides the writer used for WritePrelude/WriteCue/
+// WriteEpilogue calls. It defaults to os.Stdout.
+func (a *Analyzer) SetOutput(w io.Writer) {
+	a.output = w
+}
+
+type SystemClock int64
+
+const systemClockHz int64 = 27000000
+
+func (clock SystemClock) centitime() int64 {
+	return int64(clock) / (systemClockHz / 100)
+}
+
+// addCentiseconds returns clock shifted by n hundredths of a second,
+// as applied by a caption statement's own TIME control code.
+func (clock SystemClock) addCentiseconds(n int) SystemClock {
+	return clock + SystemClock(n)*SystemClock(systemClockHz/100)
+}
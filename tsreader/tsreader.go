@@ -0,0 +1,165 @@
+// Package tsreader reads MPEG2-TS packets from a stream, transparently
+// handling the plain 188-byte packet size as well as the 192-byte
+// M2TS/BDAV variant (a 4-byte TP_Extra_Header carrying an arrival
+// timestamp ahead of each packet) and the 204-byte FEC-padded variant
+// used by some Japanese recorder dumps, and resynchronizing after
+// corrupted input instead of failing outright.
+package tsreader
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// TsPacketSize is the size of the MPEG2-TS packet payload itself,
+// independent of any recorder-specific framing around it.
+const TsPacketSize = 188
+
+// candidate packet strides to sniff for, in the order we prefer them
+// when more than one appears to fit.
+var candidateSizes = []int{188, 192, 204}
+
+// ErrSyncLost is returned by NewReader when no 0x47 sync byte pattern
+// could be located within the sniffed prefix of the stream.
+var ErrSyncLost = errors.New("tsreader: could not locate TS sync pattern")
+
+// Packet is a single demuxed MPEG2-TS packet.
+type Packet struct {
+	// Data is always exactly TsPacketSize (188) bytes.
+	Data []byte
+	// ArrivalTimestamp is the 4-byte TP_Extra_Header that precedes
+	// 192-byte M2TS/BDAV packets, or nil when the stream has no such
+	// framing. tscaption.Analyzer can fall back to this as a clock source
+	// before a PCR PID is known.
+	ArrivalTimestamp []byte
+}
+
+// Reader demuxes a stream of TS packets, regardless of which of the
+// 188/192/204-byte variants it is encoded in.
+type Reader struct {
+	r          *bufio.Reader
+	stride     int // detected packet stride, including any prefix/padding
+	prefixSize int // bytes preceding the 188-byte TS packet within stride
+
+	// ResyncCount counts how many times NextPacket had to scan forward
+	// to recover a lost sync byte.
+	ResyncCount int
+}
+
+// NewReader wraps r and sniffs its packet size variant by locating
+// repeating 0x47 sync bytes at a candidate stride within the first few
+// kilobytes of input.
+func NewReader(r io.Reader) (*Reader, error) {
+	br := bufio.NewReaderSize(r, 64*1024)
+	stride, prefixSize, err := sniff(br)
+	if err != nil {
+		return nil, err
+	}
+	return &Reader{r: br, stride: stride, prefixSize: prefixSize}, nil
+}
+
+// sniff peeks into br, discards any partial packet ahead of the first
+// whole frame, and returns the detected (stride, prefixSize).
+func sniff(br *bufio.Reader) (stride int, prefixSize int, err error) {
+	const sniffWindow = 4 * 1024
+	buf, _ := br.Peek(sniffWindow)
+	if len(buf) == 0 {
+		return 0, 0, io.EOF
+	}
+
+	for _, size := range candidateSizes {
+		// try every plausible offset of the first sync byte within one stride
+		for offset := 0; offset < size && offset < len(buf); offset++ {
+			if buf[offset] != 0x47 {
+				continue
+			}
+			repeats := 0
+			for pos := offset; pos < len(buf); pos += size {
+				if buf[pos] != 0x47 {
+					break
+				}
+				repeats++
+			}
+			if repeats >= 3 {
+				// Only the 192-byte stride carries a header ahead of
+				// the sync byte; other leading bytes are a partial
+				// packet and are skipped.
+				prefix := 0
+				if size == 192 {
+					prefix = 4
+				}
+				if _, err := br.Discard((offset - prefix + size) % size); err != nil {
+					return 0, 0, err
+				}
+				return size, prefix, nil
+			}
+		}
+	}
+	return 0, 0, ErrSyncLost
+}
+
+// NextPacket reads and returns the next TS packet, resynchronizing on
+// the underlying stream if a sync byte is missing where expected.
+func (r *Reader) NextPacket() (Packet, error) {
+	frame := make([]byte, r.stride)
+	if _, err := io.ReadFull(r.r, frame); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			// A short final read means the stream ends mid-packet, e.g.
+			// a capture truncated in progress; treat it the same as a
+			// clean EOF rather than failing the whole run.
+			return Packet{}, io.EOF
+		}
+		return Packet{}, err
+	}
+
+	if frame[r.prefixSize] != 0x47 {
+		if err := r.resync(frame); err != nil {
+			return Packet{}, err
+		}
+	}
+
+	pkt := Packet{Data: frame[r.prefixSize : r.prefixSize+TsPacketSize]}
+	if r.prefixSize == 4 {
+		pkt.ArrivalTimestamp = frame[:4]
+	}
+	return pkt, nil
+}
+
+// resync is called when frame's expected sync byte is missing. It
+// scans byte-by-byte through the stream until it finds a new 0x47
+// aligned with the expected stride, refilling frame in place.
+func (r *Reader) resync(frame []byte) error {
+	r.ResyncCount++
+	fmt.Fprintf(os.Stderr, "tsreader: lost sync, resynchronizing (resync #%d)\n", r.ResyncCount)
+
+	window := make([]byte, 0, len(frame))
+	window = append(window, frame...)
+	for {
+		// drop the oldest byte, shift in one new byte, and see if the
+		// sync byte now lines up at prefixSize.
+		copy(window, window[1:])
+		b, err := r.r.ReadByte()
+		if err != nil {
+			return err
+		}
+		window[len(window)-1] = b
+
+		if window[r.prefixSize] == 0x47 {
+			// confirm the next expected sync byte also lines up, to
+			// avoid re-locking onto a coincidental 0x47 in the payload
+			next := make([]byte, r.stride)
+			peeked, err := r.r.Peek(r.stride)
+			if err == nil {
+				copy(next, peeked)
+				if next[r.prefixSize] != 0x47 {
+					continue
+				}
+			}
+			copy(frame, window)
+			return nil
+		}
+	}
+}
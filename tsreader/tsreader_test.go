@@ -0,0 +1,44 @@
+package tsreader
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+// syncedPackets builds n consecutive 188-byte packets, each starting
+// with the 0x47 sync byte, so NewReader sniffs a plain 188-byte stride.
+func syncedPackets(n int) []byte {
+	buf := make([]byte, 0, n*TsPacketSize)
+	for i := 0; i < n; i++ {
+		pkt := make([]byte, TsPacketSize)
+		pkt[0] = 0x47
+		buf = append(buf, pkt...)
+	}
+	return buf
+}
+
+func TestNextPacket_TruncatedFinalPacketEndsStreamCleanly(t *testing.T) {
+	data := syncedPackets(3)
+	data = append(data, data[:100]...) // a short, truncated trailing packet
+
+	r, err := NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("NewReader: %v", err)
+	}
+
+	count := 0
+	for {
+		_, err := r.NextPacket()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("NextPacket returned %v, want io.EOF for a truncated trailing packet", err)
+		}
+		count++
+	}
+	if count != 3 {
+		t.Errorf("read %d complete packets, want 3", count)
+	}
+}